@@ -3,12 +3,18 @@ package airvisual
 
 import (
 	"net/http"
+	"strings"
 )
 
 // Client is a client to work with airvisual API
 type Client struct {
 	client       *http.Client
 	baseEndpoint string
+	retry        *RetryPolicy
+	limiter      *limiter
+	cache        *responseCache
+	units        UnitSystem
+	known        catalogIndex
 
 	APIKey string
 }
@@ -37,3 +43,10 @@ func WithHTTPClient(client *http.Client) Option {
 		c.client = client
 	}
 }
+
+// WithBaseEndpoint set base endpoint of the API, useful to point airvisual client to a proxy or fake server
+func WithBaseEndpoint(endpoint string) Option {
+	return func(c *Client) {
+		c.baseEndpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
@@ -34,6 +34,18 @@ func TestClient(t *testing.T) {
 				APIKey:       "API Key",
 			},
 		},
+		{
+			name: "client with base endpoint",
+			got: New(
+				"API Key",
+				WithBaseEndpoint("http://127.0.0.1:8080/"),
+			),
+			want: &Client{
+				client:       http.DefaultClient,
+				baseEndpoint: "http://127.0.0.1:8080",
+				APIKey:       "API Key",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,412 @@
+package airvisualtest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/johanavril/airvisual"
+)
+
+// Fake is an in-memory implementation of airvisual.API serving fixtures without any networking
+type Fake struct {
+	*fixtures
+
+	mu     sync.Mutex
+	errors map[string][]*scriptedError
+	calls  map[string]int
+	units  airvisual.UnitSystem
+}
+
+type scriptedError struct {
+	err   error
+	times int
+}
+
+var _ airvisual.API = (*Fake)(nil)
+
+// NewFake return an empty Fake, seed it with the Add and Set methods
+func NewFake() *Fake {
+	return &Fake{
+		fixtures: newFixtures(),
+		errors:   map[string][]*scriptedError{},
+		calls:    map[string]int{},
+	}
+}
+
+// InjectError make calls to the named method, e.g. "City", return err.
+// An empty method affects every method and times 0 means every call
+func (f *Fake) InjectError(method string, err error, times int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.errors[method] = append(f.errors[method], &scriptedError{err: err, times: times})
+}
+
+// ClearErrors remove every injected error
+func (f *Fake) ClearErrors() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.errors = map[string][]*scriptedError{}
+}
+
+// Calls return number of calls to the named method, an empty method counts every call
+func (f *Fake) Calls(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if method != "" {
+		return f.calls[method]
+	}
+
+	total := 0
+	for _, n := range f.calls {
+		total += n
+	}
+
+	return total
+}
+
+// call record a call to the method and return its fixture or error
+func (f *Fake) call(ctx context.Context, method, path string, q url.Values) (interface{}, error) {
+	f.mu.Lock()
+	f.calls[method]++
+	err := f.nextError(method)
+	f.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, message := f.lookup(path, q)
+	if message != "" {
+		return nil, &airvisual.APIError{
+			HTTPStatus: http.StatusBadRequest,
+			Status:     "fail",
+			Message:    message,
+			Endpoint:   path,
+		}
+	}
+
+	return data, nil
+}
+
+func (f *Fake) nextError(method string) error {
+	for _, m := range []string{method, ""} {
+		scripted := f.errors[m]
+		if len(scripted) == 0 {
+			continue
+		}
+
+		err := scripted[0].err
+		if scripted[0].times > 0 {
+			scripted[0].times--
+			if scripted[0].times == 0 {
+				f.errors[m] = scripted[1:]
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func location(city, state, country string) url.Values {
+	return url.Values{
+		"city":    {city},
+		"state":   {state},
+		"country": {country},
+	}
+}
+
+func coordinates(lat, lon float64) url.Values {
+	return url.Values{
+		"lat": {strconv.FormatFloat(lat, 'f', -1, 64)},
+		"lon": {strconv.FormatFloat(lon, 'f', -1, 64)},
+	}
+}
+
+// Countries list seeded countries
+func (f *Fake) Countries() ([]*airvisual.Countries, error) {
+	return f.CountriesContext(context.Background())
+}
+
+// CountriesContext is like Countries but takes a context
+func (f *Fake) CountriesContext(ctx context.Context) ([]*airvisual.Countries, error) {
+	data, err := f.call(ctx, "Countries", CountriesPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	return data.([]*airvisual.Countries), nil
+}
+
+// States list seeded states in the specified country
+func (f *Fake) States(country string) ([]*airvisual.States, error) {
+	return f.StatesContext(context.Background(), country)
+}
+
+// StatesContext is like States but takes a context
+func (f *Fake) StatesContext(ctx context.Context, country string) ([]*airvisual.States, error) {
+	data, err := f.call(ctx, "States", StatesPath, location("", "", country))
+	if err != nil {
+		return nil, err
+	}
+
+	return data.([]*airvisual.States), nil
+}
+
+// Cities list seeded cities in the specified state
+func (f *Fake) Cities(state, country string) ([]*airvisual.Cities, error) {
+	return f.CitiesContext(context.Background(), state, country)
+}
+
+// CitiesContext is like Cities but takes a context
+func (f *Fake) CitiesContext(ctx context.Context, state, country string) ([]*airvisual.Cities, error) {
+	data, err := f.call(ctx, "Cities", CitiesPath, location("", state, country))
+	if err != nil {
+		return nil, err
+	}
+
+	return data.([]*airvisual.Cities), nil
+}
+
+// City return seeded city's data object
+func (f *Fake) City(city, state, country string) (*airvisual.City, error) {
+	return f.CityContext(context.Background(), city, state, country)
+}
+
+// CityContext is like City but takes a context
+func (f *Fake) CityContext(ctx context.Context, city, state, country string) (*airvisual.City, error) {
+	data, err := f.call(ctx, "City", CityPath, location(city, state, country))
+	if err != nil {
+		return nil, err
+	}
+
+	return data.(*airvisual.City), nil
+}
+
+// CityByRef is like City but takes a reference, the call is recorded as City
+func (f *Fake) CityByRef(ref airvisual.CityRef) (*airvisual.City, error) {
+	return f.CityByRefContext(context.Background(), ref)
+}
+
+// CityByRefContext is like CityByRef but takes a context
+func (f *Fake) CityByRefContext(ctx context.Context, ref airvisual.CityRef) (*airvisual.City, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return f.CityContext(ctx, ref.City, ref.State, ref.Country)
+}
+
+// NearestCityIP return city seeded with SetNearestCityIP
+func (f *Fake) NearestCityIP() (*airvisual.City, error) {
+	return f.NearestCityIPContext(context.Background())
+}
+
+// NearestCityIPContext is like NearestCityIP but takes a context
+func (f *Fake) NearestCityIPContext(ctx context.Context) (*airvisual.City, error) {
+	data, err := f.call(ctx, "NearestCityIP", NearestCityPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	return data.(*airvisual.City), nil
+}
+
+// NearestCityGPS return city seeded with SetNearestCity for the coordinates
+func (f *Fake) NearestCityGPS(lat, lon float64) (*airvisual.City, error) {
+	return f.NearestCityGPSContext(context.Background(), lat, lon)
+}
+
+// NearestCityGPSContext is like NearestCityGPS but takes a context
+func (f *Fake) NearestCityGPSContext(ctx context.Context, lat, lon float64) (*airvisual.City, error) {
+	data, err := f.call(ctx, "NearestCityGPS", NearestCityPath, coordinates(lat, lon))
+	if err != nil {
+		return nil, err
+	}
+
+	return data.(*airvisual.City), nil
+}
+
+// NearestCityGPSDistance return city seeded with SetNearestCity for the coordinates and its distance,
+// the call is recorded as NearestCityGPS
+func (f *Fake) NearestCityGPSDistance(lat, lon float64) (*airvisual.City, float64, error) {
+	return f.NearestCityGPSDistanceContext(context.Background(), lat, lon)
+}
+
+// NearestCityGPSDistanceContext is like NearestCityGPSDistance but takes a context
+func (f *Fake) NearestCityGPSDistanceContext(ctx context.Context, lat, lon float64) (*airvisual.City, float64, error) {
+	city, err := f.NearestCityGPSContext(ctx, lat, lon)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	distance, err := city.DistanceFrom(lat, lon)
+
+	return city, distance, err
+}
+
+// CityRanking return seeded city ranking
+func (f *Fake) CityRanking() ([]*airvisual.CityRanking, error) {
+	return f.CityRankingContext(context.Background())
+}
+
+// CityRankingContext is like CityRanking but takes a context
+func (f *Fake) CityRankingContext(ctx context.Context) ([]*airvisual.CityRanking, error) {
+	data, err := f.call(ctx, "CityRanking", CityRankingPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	return data.([]*airvisual.CityRanking), nil
+}
+
+// Stations list seeded stations inside the specified city
+func (f *Fake) Stations(city, state, country string) ([]*airvisual.Stations, error) {
+	return f.StationsContext(context.Background(), city, state, country)
+}
+
+// StationsContext is like Stations but takes a context
+func (f *Fake) StationsContext(ctx context.Context, city, state, country string) ([]*airvisual.Stations, error) {
+	data, err := f.call(ctx, "Stations", StationsPath, location(city, state, country))
+	if err != nil {
+		return nil, err
+	}
+
+	return data.([]*airvisual.Stations), nil
+}
+
+// StationsByRef is like Stations but takes a reference, the call is recorded as Stations
+func (f *Fake) StationsByRef(ref airvisual.CityRef) ([]*airvisual.Stations, error) {
+	return f.StationsByRefContext(context.Background(), ref)
+}
+
+// StationsByRefContext is like StationsByRef but takes a context
+func (f *Fake) StationsByRefContext(ctx context.Context, ref airvisual.CityRef) ([]*airvisual.Stations, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return f.StationsContext(ctx, ref.City, ref.State, ref.Country)
+}
+
+// Station return seeded station's data object
+func (f *Fake) Station(station, city, state, country string) (*airvisual.Station, error) {
+	return f.StationContext(context.Background(), station, city, state, country)
+}
+
+// StationContext is like Station but takes a context
+func (f *Fake) StationContext(ctx context.Context, station, city, state, country string) (*airvisual.Station, error) {
+	q := location(city, state, country)
+	q.Set("station", station)
+
+	data, err := f.call(ctx, "Station", StationPath, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.(*airvisual.Station), nil
+}
+
+// StationByRef is like Station but takes a reference, the call is recorded as Station
+func (f *Fake) StationByRef(ref airvisual.StationRef) (*airvisual.Station, error) {
+	return f.StationByRefContext(context.Background(), ref)
+}
+
+// StationByRefContext is like StationByRef but takes a context
+func (f *Fake) StationByRefContext(ctx context.Context, ref airvisual.StationRef) (*airvisual.Station, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return f.StationContext(ctx, ref.Station, ref.City, ref.State, ref.Country)
+}
+
+// NearestStationIP return station seeded with SetNearestStationIP
+func (f *Fake) NearestStationIP() (*airvisual.Station, error) {
+	return f.NearestStationIPContext(context.Background())
+}
+
+// NearestStationIPContext is like NearestStationIP but takes a context
+func (f *Fake) NearestStationIPContext(ctx context.Context) (*airvisual.Station, error) {
+	data, err := f.call(ctx, "NearestStationIP", NearestStationPath, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	return data.(*airvisual.Station), nil
+}
+
+// NearestStationGPS return station seeded with SetNearestStation for the coordinates
+func (f *Fake) NearestStationGPS(lat, lon float64) (*airvisual.Station, error) {
+	return f.NearestStationGPSContext(context.Background(), lat, lon)
+}
+
+// NearestStationGPSContext is like NearestStationGPS but takes a context
+func (f *Fake) NearestStationGPSContext(ctx context.Context, lat, lon float64) (*airvisual.Station, error) {
+	data, err := f.call(ctx, "NearestStationGPS", NearestStationPath, coordinates(lat, lon))
+	if err != nil {
+		return nil, err
+	}
+
+	return data.(*airvisual.Station), nil
+}
+
+// NearestStationGPSDistance return station seeded with SetNearestStation for the coordinates and its distance,
+// the call is recorded as NearestStationGPS
+func (f *Fake) NearestStationGPSDistance(lat, lon float64) (*airvisual.Station, float64, error) {
+	return f.NearestStationGPSDistanceContext(context.Background(), lat, lon)
+}
+
+// NearestStationGPSDistanceContext is like NearestStationGPSDistance but takes a context
+func (f *Fake) NearestStationGPSDistanceContext(ctx context.Context, lat, lon float64) (*airvisual.Station, float64, error) {
+	station, err := f.NearestStationGPSContext(ctx, lat, lon)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	distance, err := station.DistanceFrom(lat, lon)
+
+	return station, distance, err
+}
+
+// Quota return an unlimited quota
+func (f *Fake) Quota() airvisual.Quota {
+	return airvisual.Quota{Minute: -1, Day: -1, Month: -1}
+}
+
+// SetUnitSystem set the unit system returned by UnitSystem
+func (f *Fake) SetUnitSystem(units airvisual.UnitSystem) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.units = units
+}
+
+// UnitSystem return the unit system set with SetUnitSystem, Metric by default, it is used by FormatWeather and
+// FormatForecast
+func (f *Fake) UnitSystem() airvisual.UnitSystem {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.units
+}
+
+// FormatWeather format a reading using the fake's unit system
+func (f *Fake) FormatWeather(w *airvisual.Weather) string {
+	return f.UnitSystem().FormatWeather(w)
+}
+
+// FormatForecast format a forecast using the fake's unit system
+func (f *Fake) FormatForecast(forecast *airvisual.Forecast) string {
+	return f.UnitSystem().FormatForecast(forecast)
+}
@@ -0,0 +1,150 @@
+package airvisualtest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/johanavril/airvisual"
+)
+
+func TestFake(t *testing.T) {
+	fake := NewFake()
+	city := &airvisual.City{
+		City:     "Los Angeles",
+		State:    "California",
+		Country:  "USA",
+		Location: &airvisual.Location{Type: "Point", Coordinates: []float64{-118.2417, 34.0669}},
+	}
+	fake.SetCity(city)
+	fake.SetNearestCity(34.0669, -118.2417, city)
+	fake.AddCountries("USA")
+
+	var api airvisual.API = fake
+
+	got, err := api.City("Los Angeles", "California", "USA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(city, got) {
+		t.Errorf("expected %#v , got %#v", city, got)
+	}
+
+	got, err = api.NearestCityGPS(34.0669, -118.2417)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(city, got) {
+		t.Errorf("expected %#v , got %#v", city, got)
+	}
+
+	got, distance, err := api.NearestCityGPSDistance(34.0669, -118.2417)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(city, got) || distance != 0 {
+		t.Errorf("expected %#v at 0 km , got %#v at %v km", city, got, distance)
+	}
+
+	_, err = api.City("Paris", "Ile-de-France", "France")
+	if !errors.Is(err, airvisual.ErrCityNotFound) {
+		t.Errorf("expected %v , got %v", airvisual.ErrCityNotFound, err)
+	}
+
+	_, err = api.NearestStationIP()
+	if !errors.Is(err, airvisual.ErrNoNearestStation) {
+		t.Errorf("expected %v , got %v", airvisual.ErrNoNearestStation, err)
+	}
+
+	if fake.Calls("City") != 2 || fake.Calls("NearestCityGPS") != 2 || fake.Calls("") != 5 {
+		t.Errorf("expected 2 city calls, 2 nearest city calls and 5 total , got %d, %d and %d", fake.Calls("City"), fake.Calls("NearestCityGPS"), fake.Calls(""))
+	}
+}
+
+func TestFakeFetchCities(t *testing.T) {
+	fake := NewFake()
+	fake.SetCity(&airvisual.City{City: "Los Angeles", State: "California", Country: "USA"})
+
+	results := airvisual.FetchCities(context.Background(), fake, []airvisual.CityRef{
+		{City: "Los Angeles", State: "California", Country: "USA"},
+		{City: "Paris", State: "Ile-de-France", Country: "France"},
+	}, airvisual.FetchOptions{})
+	if results[0].Err != nil || results[0].City.City != "Los Angeles" {
+		t.Errorf("expected Los Angeles , got %v %v", results[0].City, results[0].Err)
+	}
+	if !errors.Is(results[1].Err, airvisual.ErrCityNotFound) {
+		t.Errorf("expected %v , got %v", airvisual.ErrCityNotFound, results[1].Err)
+	}
+}
+
+func TestFakeCrawl(t *testing.T) {
+	fake := NewFake()
+	fake.AddCountries("USA")
+	fake.AddStates("USA", "California")
+	fake.AddCities("California", "USA", "Los Angeles")
+	fake.AddStations("Los Angeles", "California", "USA", &airvisual.Stations{Station: "Los Angeles - N. Main St."})
+
+	catalog, err := airvisual.Crawl(context.Background(), fake, airvisual.CrawlOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(catalog.Countries) != 1 || catalog.Countries[0].States[0].Cities[0].Stations[0].Name != "Los Angeles - N. Main St." {
+		t.Errorf("unexpected catalog %#v", catalog)
+	}
+}
+
+func TestFakeUnitSystem(t *testing.T) {
+	fake := NewFake()
+
+	if fake.UnitSystem() != airvisual.Metric {
+		t.Errorf("expected %v , got %v", airvisual.Metric, fake.UnitSystem())
+	}
+	fake.SetUnitSystem(airvisual.Imperial)
+	if fake.UnitSystem() != airvisual.Imperial {
+		t.Errorf("expected %v , got %v", airvisual.Imperial, fake.UnitSystem())
+	}
+	if got, want := fake.FormatWeather(&airvisual.Weather{TP: 21, HU: 64, PR: 1013.25, WS: 3.6, WD: 315}), "69.8 °F, 64 %, 29.92 inHg, 8.1 mph NW"; want != got {
+		t.Errorf("expected %s , got %s", want, got)
+	}
+}
+
+func TestFakeInjectError(t *testing.T) {
+	fake := NewFake()
+	fake.AddCountries("USA")
+
+	limit := &airvisual.APIError{Status: "call_limit_reached", Endpoint: CountriesPath}
+	fake.InjectError("Countries", limit, 1)
+
+	_, err := fake.Countries()
+	if !errors.Is(err, airvisual.ErrCallLimitReached) {
+		t.Errorf("expected %v , got %v", airvisual.ErrCallLimitReached, err)
+	}
+
+	countries, err := fake.Countries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(countries) != 1 {
+		t.Errorf("expected 1 country , got %d", len(countries))
+	}
+
+	down := errors.New("network down")
+	fake.InjectError("", down, 0)
+
+	for i := 0; i < 2; i++ {
+		if _, err := fake.States("USA"); err != down {
+			t.Errorf("expected %v , got %v", down, err)
+		}
+	}
+
+	fake.ClearErrors()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = fake.StatesContext(ctx, "USA")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v , got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,221 @@
+package airvisualtest
+
+import (
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/johanavril/airvisual"
+)
+
+type cityKey struct {
+	city, state, country string
+}
+
+type stationKey struct {
+	station string
+	cityKey
+}
+
+type point struct {
+	lat, lon float64
+}
+
+// fixtures holds the data served by Server and Fake
+type fixtures struct {
+	mu             sync.Mutex
+	countries      []*airvisual.Countries
+	states         map[string][]*airvisual.States
+	cities         map[cityKey][]*airvisual.Cities
+	stations       map[cityKey][]*airvisual.Stations
+	city           map[cityKey]*airvisual.City
+	station        map[stationKey]*airvisual.Station
+	nearestCity    map[point]*airvisual.City
+	nearestStation map[point]*airvisual.Station
+	nearestCityIP  *airvisual.City
+	nearestStaIP   *airvisual.Station
+	ranking        []*airvisual.CityRanking
+}
+
+func newFixtures() *fixtures {
+	return &fixtures{
+		states:         map[string][]*airvisual.States{},
+		cities:         map[cityKey][]*airvisual.Cities{},
+		stations:       map[cityKey][]*airvisual.Stations{},
+		city:           map[cityKey]*airvisual.City{},
+		station:        map[stationKey]*airvisual.Station{},
+		nearestCity:    map[point]*airvisual.City{},
+		nearestStation: map[point]*airvisual.Station{},
+	}
+}
+
+// AddCountries add countries served by the countries endpoint
+func (f *fixtures) AddCountries(countries ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, country := range countries {
+		f.countries = append(f.countries, &airvisual.Countries{Country: country})
+	}
+}
+
+// AddStates add states of a country served by the states endpoint
+func (f *fixtures) AddStates(country string, states ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, state := range states {
+		f.states[country] = append(f.states[country], &airvisual.States{State: state})
+	}
+}
+
+// AddCities add cities of a state served by the cities endpoint
+func (f *fixtures) AddCities(state, country string, cities ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := cityKey{state: state, country: country}
+	for _, city := range cities {
+		f.cities[key] = append(f.cities[key], &airvisual.Cities{City: city})
+	}
+}
+
+// AddStations add stations of a city served by the stations endpoint
+func (f *fixtures) AddStations(city, state, country string, stations ...*airvisual.Stations) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := cityKey{city, state, country}
+	f.stations[key] = append(f.stations[key], stations...)
+}
+
+// SetCity set data served by the city endpoint for the city named in the fixture
+func (f *fixtures) SetCity(city *airvisual.City) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.city[cityKey{city.City, city.State, city.Country}] = city
+}
+
+// SetStation set data served by the station endpoint for the station named in the fixture
+func (f *fixtures) SetStation(station *airvisual.Station) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.station[stationKey{station.Name, cityKey{station.City, station.State, station.Country}}] = station
+}
+
+// SetNearestCity set city served by the nearest city endpoint for the given GPS coordinates
+func (f *fixtures) SetNearestCity(lat, lon float64, city *airvisual.City) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nearestCity[point{lat, lon}] = city
+}
+
+// SetNearestCityIP set city served by the nearest city endpoint when no coordinates are given
+func (f *fixtures) SetNearestCityIP(city *airvisual.City) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nearestCityIP = city
+}
+
+// SetNearestStation set station served by the nearest station endpoint for the given GPS coordinates
+func (f *fixtures) SetNearestStation(lat, lon float64, station *airvisual.Station) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nearestStation[point{lat, lon}] = station
+}
+
+// SetNearestStationIP set station served by the nearest station endpoint when no coordinates are given
+func (f *fixtures) SetNearestStationIP(station *airvisual.Station) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nearestStaIP = station
+}
+
+// SetCityRanking set ranking served by the city ranking endpoint
+func (f *fixtures) SetCityRanking(ranking ...*airvisual.CityRanking) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.ranking = ranking
+}
+
+// lookup return the fixture served by an endpoint for the query parameters,
+// or the AirVisual status message when there is none
+func (f *fixtures) lookup(path string, q url.Values) (interface{}, string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := cityKey{q.Get("city"), q.Get("state"), q.Get("country")}
+
+	switch path {
+	case CountriesPath:
+		return append([]*airvisual.Countries{}, f.countries...), ""
+	case StatesPath:
+		return append([]*airvisual.States{}, f.states[key.country]...), ""
+	case CitiesPath:
+		return append([]*airvisual.Cities{}, f.cities[cityKey{state: key.state, country: key.country}]...), ""
+	case StationsPath:
+		return append([]*airvisual.Stations{}, f.stations[key]...), ""
+	case CityRankingPath:
+		return append([]*airvisual.CityRanking{}, f.ranking...), ""
+	case CityPath:
+		city, ok := f.city[key]
+		if !ok {
+			return nil, "city_not_found"
+		}
+		return city, ""
+	case StationPath:
+		station, ok := f.station[stationKey{q.Get("station"), key}]
+		if !ok {
+			return nil, "station_not_found"
+		}
+		return station, ""
+	case NearestCityPath:
+		city := f.nearestCityIP
+		if q.Get("lat") != "" || q.Get("lon") != "" {
+			p, ok := parsePoint(q.Get("lat"), q.Get("lon"))
+			if !ok {
+				return nil, "invalid_coordinates"
+			}
+			city = f.nearestCity[p]
+		}
+		if city == nil {
+			return nil, "no_nearest_city"
+		}
+		return city, ""
+	case NearestStationPath:
+		station := f.nearestStaIP
+		if q.Get("lat") != "" || q.Get("lon") != "" {
+			p, ok := parsePoint(q.Get("lat"), q.Get("lon"))
+			if !ok {
+				return nil, "invalid_coordinates"
+			}
+			station = f.nearestStation[p]
+		}
+		if station == nil {
+			return nil, "no_nearest_station"
+		}
+		return station, ""
+	}
+
+	return nil, "node_not_found"
+}
+
+func parsePoint(lat, lon string) (point, bool) {
+	la, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		return point{}, false
+	}
+	lo, err := strconv.ParseFloat(lon, 64)
+	if err != nil {
+		return point{}, false
+	}
+
+	return point{la, lo}, true
+}
@@ -0,0 +1,223 @@
+// Package airvisualtest provides a programmable fake of AirVisual's API for testing
+package airvisualtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/johanavril/airvisual"
+)
+
+// Paths of the endpoints served by the fake server
+const (
+	CountriesPath      = "/v2/countries"
+	StatesPath         = "/v2/states"
+	CitiesPath         = "/v2/cities"
+	NearestCityPath    = "/v2/nearest_city"
+	CityPath           = "/v2/city"
+	StationsPath       = "/v2/stations"
+	NearestStationPath = "/v2/nearest_station"
+	StationPath        = "/v2/station"
+	CityRankingPath    = "/v2/city_ranking"
+)
+
+// Fault describes an error injected into the responses of an endpoint
+type Fault struct {
+	Status     int           // HTTP status code, defaults to 400 when Message is set and 200 when Body is set
+	Message    string        // AirVisual status code, e.g. "call_limit_reached"
+	Body       string        // raw response body, overrides Message, e.g. malformed JSON
+	Latency    time.Duration // delay before responding, a fault with only latency still serves the fixtures
+	RetryAfter string        // value of the Retry-After header
+	Times      int           // number of requests affected, 0 means every request
+}
+
+// Server is a fake AirVisual API server backed by fixtures
+type Server struct {
+	*httptest.Server
+
+	*fixtures
+
+	APIKey string
+
+	mu       sync.Mutex
+	faults   map[string][]*Fault
+	requests map[string]int
+}
+
+// NewServer start a fake server accepting the given API key
+func NewServer(apiKey string) *Server {
+	s := &Server{
+		fixtures: newFixtures(),
+		APIKey:   apiKey,
+		faults:   map[string][]*Fault{},
+		requests: map[string]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// NewClient start a fake server and return a client pointed at it
+func NewClient(apiKey string, opts ...airvisual.Option) (*airvisual.Client, *Server) {
+	s := NewServer(apiKey)
+
+	return s.AirVisualClient(opts...), s
+}
+
+// AirVisualClient return a client using the server's API key pointed at the server, Client still return the
+// *http.Client of the embedded httptest.Server
+func (s *Server) AirVisualClient(opts ...airvisual.Option) *airvisual.Client {
+	opts = append([]airvisual.Option{
+		airvisual.WithHTTPClient(s.Server.Client()),
+		airvisual.WithBaseEndpoint(s.URL),
+	}, opts...)
+
+	return airvisual.New(s.APIKey, opts...)
+}
+
+// InjectFault queue a fault for requests to the given path, an empty path affects every endpoint
+func (s *Server) InjectFault(path string, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := fault
+	s.faults[path] = append(s.faults[path], &f)
+}
+
+// ClearFaults remove every injected fault
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = map[string][]*Fault{}
+}
+
+// Requests return number of requests received by the given path, an empty path counts every request
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if path != "" {
+		return s.requests[path]
+	}
+
+	total := 0
+	for _, n := range s.requests {
+		total += n
+	}
+
+	return total
+}
+
+// nextFault return the first pending fault of the path, consuming it if it is limited
+func (s *Server) nextFault(path string) *Fault {
+	for _, p := range []string{path, ""} {
+		faults := s.faults[p]
+		if len(faults) == 0 {
+			continue
+		}
+
+		fault := *faults[0]
+		if faults[0].Times > 0 {
+			faults[0].Times--
+			if faults[0].Times == 0 {
+				s.faults[p] = faults[1:]
+			}
+		}
+
+		return &fault
+	}
+
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests[r.URL.Path]++
+	fault := s.nextFault(r.URL.Path)
+	s.mu.Unlock()
+
+	if fault != nil && !writeFault(w, r, fault) {
+		return
+	}
+
+	q := r.URL.Query()
+	if q.Get("key") != s.APIKey {
+		writeError(w, http.StatusUnauthorized, "incorrect_api_key")
+		return
+	}
+
+	data, message := s.lookup(r.URL.Path, q)
+	switch {
+	case message == "node_not_found":
+		writeError(w, http.StatusNotFound, message)
+	case message != "":
+		writeError(w, http.StatusBadRequest, message)
+	default:
+		writeData(w, data)
+	}
+}
+
+// writeFault write the injected fault, it return true when the request should still be served normally
+func writeFault(w http.ResponseWriter, r *http.Request, fault *Fault) bool {
+	if fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+	}
+
+	if fault.RetryAfter != "" {
+		w.Header().Set("Retry-After", fault.RetryAfter)
+	}
+
+	status := fault.Status
+	switch {
+	case fault.Body != "":
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(fault.Body))
+	case fault.Message != "":
+		if status == 0 {
+			status = http.StatusBadRequest
+		}
+		writeError(w, status, fault.Message)
+	case status != 0:
+		w.WriteHeader(status)
+	default:
+		return true
+	}
+
+	return false
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   data,
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"status": "fail",
+		"data": map[string]string{
+			"message": message,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
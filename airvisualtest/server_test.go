@@ -0,0 +1,196 @@
+package airvisualtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/johanavril/airvisual"
+)
+
+func TestServerFixtures(t *testing.T) {
+	client, server := NewClient("API Key")
+	defer server.Close()
+
+	server.AddCountries("USA", "China")
+	server.AddStates("USA", "California")
+	server.AddCities("California", "USA", "Los Angeles")
+	server.AddStations("Los Angeles", "California", "USA", &airvisual.Stations{Station: "Compton"})
+	city := &airvisual.City{City: "Los Angeles", State: "California", Country: "USA"}
+	server.SetCity(city)
+	server.SetNearestCity(34.0669, -118.2417, city)
+	station := &airvisual.Station{Name: "Compton", City: "Los Angeles", State: "California", Country: "USA"}
+	server.SetStation(station)
+	server.SetNearestStationIP(station)
+
+	countries, err := client.Countries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*airvisual.Countries{{Country: "USA"}, {Country: "China"}}; !reflect.DeepEqual(want, countries) {
+		t.Errorf("expected %#v , got %#v", want, countries)
+	}
+
+	states, err := client.States("China")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(states) != 0 {
+		t.Errorf("expected no states , got %#v", states)
+	}
+
+	cities, err := client.Cities("California", "USA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*airvisual.Cities{{City: "Los Angeles"}}; !reflect.DeepEqual(want, cities) {
+		t.Errorf("expected %#v , got %#v", want, cities)
+	}
+
+	stations, err := client.Stations("Los Angeles", "California", "USA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*airvisual.Stations{{Station: "Compton"}}; !reflect.DeepEqual(want, stations) {
+		t.Errorf("expected %#v , got %#v", want, stations)
+	}
+
+	gotCity, err := client.City("Los Angeles", "California", "USA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(city, gotCity) {
+		t.Errorf("expected %#v , got %#v", city, gotCity)
+	}
+
+	gotCity, err = client.NearestCityGPS(34.0669, -118.2417)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(city, gotCity) {
+		t.Errorf("expected %#v , got %#v", city, gotCity)
+	}
+
+	gotStation, err := client.NearestStationIP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(station, gotStation) {
+		t.Errorf("expected %#v , got %#v", station, gotStation)
+	}
+
+	if server.Requests(CityPath) != 1 || server.Requests("") != 7 {
+		t.Errorf("expected 1 city request and 7 total , got %d and %d", server.Requests(CityPath), server.Requests(""))
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		apiKey   string
+		fault    *Fault
+		sentinel error
+		status   int
+	}{
+		{
+			name:     "incorrect api key",
+			apiKey:   "Wrong Key",
+			sentinel: airvisual.ErrIncorrectAPIKey,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			name:     "unknown city",
+			apiKey:   "API Key",
+			sentinel: airvisual.ErrCityNotFound,
+			status:   http.StatusBadRequest,
+		},
+		{
+			name:     "injected call limit",
+			apiKey:   "API Key",
+			fault:    &Fault{Status: http.StatusTooManyRequests, Message: "call_limit_reached"},
+			sentinel: airvisual.ErrCallLimitReached,
+			status:   http.StatusTooManyRequests,
+		},
+		{
+			name:   "injected server error",
+			apiKey: "API Key",
+			fault:  &Fault{Status: http.StatusServiceUnavailable},
+			status: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := NewServer("API Key")
+			defer server.Close()
+
+			if test.fault != nil {
+				server.InjectFault(CityPath, *test.fault)
+			}
+
+			_, err := server.AirVisualClient().City("Nowhere", "California", "USA")
+			if test.apiKey != server.APIKey {
+				client := airvisual.New(test.apiKey, airvisual.WithBaseEndpoint(server.URL))
+				_, err = client.City("Nowhere", "California", "USA")
+			}
+
+			var apiErr *airvisual.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *airvisual.APIError , got %#v", err)
+			}
+			if test.status != apiErr.HTTPStatus {
+				t.Errorf("expected HTTP status %d , got %d", test.status, apiErr.HTTPStatus)
+			}
+			if test.sentinel != nil && !errors.Is(err, test.sentinel) {
+				t.Errorf("expected error to match %v , got %v", test.sentinel, err)
+			}
+		})
+	}
+}
+
+func TestServerFaultTimes(t *testing.T) {
+	client, server := NewClient("API Key")
+	defer server.Close()
+
+	server.AddCountries("USA")
+	server.InjectFault("", Fault{Body: `{"status": "success", "data": [`, Times: 1})
+
+	_, err := client.Countries()
+	if err == nil {
+		t.Errorf("expected malformed JSON error")
+	}
+
+	countries, err := client.Countries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(countries) != 1 {
+		t.Errorf("expected 1 country , got %d", len(countries))
+	}
+}
+
+func TestServerLatency(t *testing.T) {
+	client, server := NewClient("API Key")
+	defer server.Close()
+
+	server.AddCountries("USA")
+	server.InjectFault(CountriesPath, Fault{Latency: time.Second})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.CountriesContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v , got %v", context.DeadlineExceeded, err)
+	}
+
+	server.ClearFaults()
+
+	_, err = client.Countries()
+	if err != nil {
+		t.Errorf("expected no error , got %v", err)
+	}
+}
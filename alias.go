@@ -0,0 +1,125 @@
+package airvisual
+
+// AliasKind is the level of the location hierarchy an alias applies to
+type AliasKind int
+
+// Alias kinds
+const (
+	CountryAlias AliasKind = iota
+	StateAlias
+	CityAlias
+	StationAlias
+)
+
+func (k AliasKind) String() string {
+	switch k {
+	case CountryAlias:
+		return "country"
+	case StateAlias:
+		return "state"
+	case CityAlias:
+		return "city"
+	case StationAlias:
+		return "station"
+	}
+
+	return "unknown"
+}
+
+// defaultAliases contains common alternative names of each kind, keys are normalized
+var defaultAliases = map[AliasKind]map[string]string{
+	CountryAlias: countryAliases,
+	StateAlias:   stateAliases,
+	CityAlias:    cityAliases,
+}
+
+// countryAliases contains ISO 3166-1 alpha-2 codes and common alternative names of countries
+var countryAliases = map[string]string{
+	"us":                       "USA",
+	"usa":                      "USA",
+	"united states":            "USA",
+	"united states of america": "USA",
+	"america":                  "USA",
+	"uk":                       "United Kingdom",
+	"great britain":            "United Kingdom",
+	"britain":                  "United Kingdom",
+	"korea":                    "South Korea",
+	"republic of korea":        "South Korea",
+	"czechia":                  "Czech Republic",
+	"viet nam":                 "Vietnam",
+	"holland":                  "Netherlands",
+	"the netherlands":          "Netherlands",
+	"uae":                      "United Arab Emirates",
+	// ISO 3166-1 alpha-2
+	"ad": "Andorra", "ae": "United Arab Emirates", "af": "Afghanistan", "ag": "Antigua and Barbuda",
+	"al": "Albania", "am": "Armenia", "ao": "Angola", "ar": "Argentina", "as": "American Samoa",
+	"at": "Austria", "au": "Australia", "aw": "Aruba", "az": "Azerbaijan", "ba": "Bosnia Herzegovina",
+	"bb": "Barbados", "bd": "Bangladesh", "be": "Belgium", "bf": "Burkina Faso", "bg": "Bulgaria",
+	"bh": "Bahrain", "bi": "Burundi", "bj": "Benin", "bm": "Bermuda", "bn": "Brunei",
+	"bo": "Bolivia", "br": "Brazil", "bs": "Bahamas", "bt": "Bhutan", "bw": "Botswana",
+	"by": "Belarus", "bz": "Belize", "ca": "Canada", "cd": "Democratic Republic of the Congo",
+	"cf": "Central African Republic", "cg": "Republic of the Congo", "ch": "Switzerland", "ci": "Ivory Coast",
+	"cl": "Chile", "cm": "Cameroon", "cn": "China", "co": "Colombia", "cr": "Costa Rica",
+	"cu": "Cuba", "cv": "Cape Verde", "cw": "Curacao", "cy": "Cyprus", "cz": "Czech Republic",
+	"de": "Germany", "dj": "Djibouti", "dk": "Denmark", "dm": "Dominica", "do": "Dominican Republic",
+	"dz": "Algeria", "ec": "Ecuador", "ee": "Estonia", "eg": "Egypt", "er": "Eritrea",
+	"es": "Spain", "et": "Ethiopia", "fi": "Finland", "fj": "Fiji", "fo": "Faroe Islands",
+	"fr": "France", "ga": "Gabon", "gb": "United Kingdom", "gd": "Grenada", "ge": "Georgia",
+	"gf": "French Guiana", "gh": "Ghana", "gi": "Gibraltar", "gl": "Greenland", "gm": "Gambia",
+	"gn": "Guinea", "gp": "Guadeloupe", "gq": "Equatorial Guinea", "gr": "Greece", "gt": "Guatemala",
+	"gu": "Guam", "gw": "Guinea-Bissau", "gy": "Guyana", "hk": "Hong Kong", "hn": "Honduras",
+	"hr": "Croatia", "ht": "Haiti", "hu": "Hungary", "id": "Indonesia", "ie": "Ireland",
+	"il": "Israel", "im": "Isle of Man", "in": "India", "iq": "Iraq", "ir": "Iran",
+	"is": "Iceland", "it": "Italy", "je": "Jersey", "jm": "Jamaica", "jo": "Jordan",
+	"jp": "Japan", "ke": "Kenya", "kg": "Kyrgyzstan", "kh": "Cambodia", "km": "Comoros",
+	"kp": "North Korea", "kr": "South Korea", "kw": "Kuwait", "ky": "Cayman Islands", "kz": "Kazakhstan",
+	"la": "Laos", "lb": "Lebanon", "lc": "Saint Lucia", "li": "Liechtenstein", "lk": "Sri Lanka",
+	"lr": "Liberia", "ls": "Lesotho", "lt": "Lithuania", "lu": "Luxembourg", "lv": "Latvia",
+	"ly": "Libya", "ma": "Morocco", "mc": "Monaco", "md": "Moldova", "me": "Montenegro",
+	"mg": "Madagascar", "mk": "North Macedonia", "ml": "Mali", "mm": "Myanmar", "mn": "Mongolia",
+	"mo": "Macao", "mq": "Martinique", "mr": "Mauritania", "mt": "Malta", "mu": "Mauritius",
+	"mv": "Maldives", "mw": "Malawi", "mx": "Mexico", "my": "Malaysia", "mz": "Mozambique",
+	"na": "Namibia", "nc": "New Caledonia", "ne": "Niger", "ng": "Nigeria", "ni": "Nicaragua",
+	"nl": "Netherlands", "no": "Norway", "np": "Nepal", "nz": "New Zealand", "om": "Oman",
+	"pa": "Panama", "pe": "Peru", "pf": "French Polynesia", "pg": "Papua New Guinea", "ph": "Philippines",
+	"pk": "Pakistan", "pl": "Poland", "pr": "Puerto Rico", "ps": "Palestine", "pt": "Portugal",
+	"py": "Paraguay", "qa": "Qatar", "re": "Reunion", "ro": "Romania", "rs": "Serbia",
+	"ru": "Russia", "rw": "Rwanda", "sa": "Saudi Arabia", "sc": "Seychelles", "sd": "Sudan",
+	"se": "Sweden", "sg": "Singapore", "si": "Slovenia", "sk": "Slovakia", "sl": "Sierra Leone",
+	"sm": "San Marino", "sn": "Senegal", "so": "Somalia", "sr": "Suriname", "ss": "South Sudan",
+	"sv": "El Salvador", "sy": "Syria", "sz": "Eswatini", "td": "Chad", "tg": "Togo",
+	"th": "Thailand", "tj": "Tajikistan", "tl": "Timor-Leste", "tm": "Turkmenistan", "tn": "Tunisia",
+	"tr": "Turkey", "tt": "Trinidad and Tobago", "tw": "Taiwan", "tz": "Tanzania", "ua": "Ukraine",
+	"ug": "Uganda", "uy": "Uruguay", "uz": "Uzbekistan", "vc": "Saint Vincent and the Grenadines", "ve": "Venezuela",
+	"vi": "U.S. Virgin Islands", "vn": "Vietnam", "xk": "Kosovo", "ye": "Yemen", "za": "South Africa",
+	"zm": "Zambia", "zw": "Zimbabwe",
+}
+
+// stateAliases contains the postal codes of US states and Canadian provinces
+var stateAliases = map[string]string{
+	"al": "Alabama", "ak": "Alaska", "az": "Arizona", "ar": "Arkansas", "ca": "California",
+	"co": "Colorado", "ct": "Connecticut", "de": "Delaware", "dc": "Washington, D.C.", "fl": "Florida",
+	"ga": "Georgia", "hi": "Hawaii", "id": "Idaho", "il": "Illinois", "in": "Indiana",
+	"ia": "Iowa", "ks": "Kansas", "ky": "Kentucky", "la": "Louisiana", "me": "Maine",
+	"md": "Maryland", "ma": "Massachusetts", "mi": "Michigan", "mn": "Minnesota", "ms": "Mississippi",
+	"mo": "Missouri", "mt": "Montana", "ne": "Nebraska", "nv": "Nevada", "nh": "New Hampshire",
+	"nj": "New Jersey", "nm": "New Mexico", "ny": "New York", "nc": "North Carolina", "nd": "North Dakota",
+	"oh": "Ohio", "ok": "Oklahoma", "or": "Oregon", "pa": "Pennsylvania", "ri": "Rhode Island",
+	"sc": "South Carolina", "sd": "South Dakota", "tn": "Tennessee", "tx": "Texas", "ut": "Utah",
+	"vt": "Vermont", "va": "Virginia", "wa": "Washington", "wv": "West Virginia", "wi": "Wisconsin",
+	"wy": "Wyoming", "pr": "Puerto Rico",
+	"ab": "Alberta", "bc": "British Columbia", "mb": "Manitoba", "nb": "New Brunswick",
+	"nl": "Newfoundland and Labrador", "ns": "Nova Scotia", "nt": "Northwest Territories", "nu": "Nunavut",
+	"on": "Ontario", "pe": "Prince Edward Island", "qc": "Quebec", "sk": "Saskatchewan", "yt": "Yukon",
+}
+
+// cityAliases contains common alternative names of cities
+var cityAliases = map[string]string{
+	"nyc":    "New York City",
+	"la":     "Los Angeles",
+	"sf":     "San Francisco",
+	"saigon": "Ho Chi Minh City",
+	"hcmc":   "Ho Chi Minh City",
+	"bombay": "Mumbai",
+	"peking": "Beijing",
+}
@@ -1,8 +1,10 @@
 package airvisual
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 )
@@ -25,22 +27,92 @@ func (c *Client) endpoint(api string, v url.Values) string {
 	return c.baseEndpoint + api + "?" + v.Encode()
 }
 
-func (c *Client) request(endpoint string, result interface{}) error {
-	response, err := c.client.Get(endpoint)
+func (c *Client) request(ctx context.Context, api string, v url.Values, result interface{}) error {
+	var body []byte
+	var err error
+
+	cached := false
+	if c.cache != nil {
+		body, cached = c.cache.lookup(api, v)
+	}
+
+	if !cached {
+		if c.retry != nil {
+			body, err = c.fetchWithRetry(ctx, api, v)
+		} else {
+			body, err = c.fetch(ctx, api, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	err = json.Unmarshal(body, result)
 	if err != nil {
-		return fmt.Errorf("failed to fetch %s: %v", endpoint, err)
+		return fmt.Errorf("cannot decode JSON: %w", err)
 	}
 
-	defer response.Body.Close()
+	if c.cache != nil && !cached {
+		c.cache.store(api, v, body)
+	}
 
-	if response.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected HTTP status %s", response.Status)
+	return nil
+}
+
+func (c *Client) fetch(ctx context.Context, api string, v url.Values) ([]byte, error) {
+	if c.limiter != nil {
+		err := c.limiter.acquire(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire rate limit for %s: %w", api, err)
+		}
 	}
 
-	err = json.NewDecoder(response.Body).Decode(result)
+	endpoint := c.endpoint(api, v)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("cannot decode JSON: %v", err)
+		return nil, fmt.Errorf("failed to create request %s: %w", endpoint, err)
 	}
 
-	return nil
+	response, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", endpoint, err)
+	}
+
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response of %s: %w", endpoint, err)
+	}
+
+	envelope := struct {
+		Status string          `json:"status"`
+		Data   json.RawMessage `json:"data"`
+	}{}
+	jsonErr := json.Unmarshal(body, &envelope)
+
+	if response.StatusCode != http.StatusOK || (jsonErr == nil && envelope.Status != "success") {
+		apiErr := &APIError{
+			HTTPStatus: response.StatusCode,
+			Status:     envelope.Status,
+			Endpoint:   api,
+			RetryAfter: parseRetryAfter(response.Header.Get("Retry-After")),
+		}
+
+		message := struct {
+			Message string `json:"message"`
+		}{}
+		if json.Unmarshal(envelope.Data, &message) == nil {
+			apiErr.Message = message.Message
+		}
+
+		return nil, apiErr
+	}
+
+	if jsonErr != nil {
+		return nil, fmt.Errorf("cannot decode JSON: %w", jsonErr)
+	}
+
+	return body, nil
 }
@@ -1,6 +1,7 @@
 package airvisual
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -8,7 +9,12 @@ import (
 )
 
 func mockClientServer(result string) (*Client, *httptest.Server) {
+	return mockClientServerStatus(http.StatusOK, result)
+}
+
+func mockClientServerStatus(status int, result string) (*Client, *httptest.Server) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
 		w.Write([]byte(result))
 	}))
 
@@ -68,3 +74,45 @@ func TestEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestContext(t *testing.T) {
+	client, server := mockClientServer(`{"status": "success", "data": []}`)
+
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		ctx     func() (context.Context, context.CancelFunc)
+		wantErr bool
+	}{
+		{
+			name: "background context",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			wantErr: false,
+		},
+		{
+			name: "canceled context",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := test.ctx()
+			defer cancel()
+
+			_, err := client.CountriesContext(ctx)
+
+			if test.wantErr != (err != nil) {
+				t.Errorf("expected error %v , got %v", test.wantErr, err)
+			}
+		})
+	}
+}
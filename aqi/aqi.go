@@ -0,0 +1,197 @@
+// Package aqi computes air quality indices from raw pollutant concentrations
+package aqi
+
+import (
+	"errors"
+	"math"
+)
+
+// Pollutant is a pollutant code, using the same codes as AirVisual's MAINUS and MAINCN fields
+type Pollutant string
+
+// Pollutants supported by the calculators
+const (
+	PM25 Pollutant = "p2" // fine particulate matter, in µg/m³
+	PM10 Pollutant = "p1" // coarse particulate matter, in µg/m³
+	O3   Pollutant = "o3" // ozone, in ppb
+	NO2  Pollutant = "n2" // nitrogen dioxide, in ppb
+	SO2  Pollutant = "s2" // sulfur dioxide, in ppb
+	CO   Pollutant = "co" // carbon monoxide, in ppm
+)
+
+// Pollutants list every supported pollutant, in the order used to break ties of the dominant pollutant
+var Pollutants = []Pollutant{PM25, PM10, O3, NO2, SO2, CO}
+
+var pollutantInfos = map[Pollutant]struct{ name, formula, unit string }{
+	PM25: {name: "Fine particulate matter", formula: "PM2.5", unit: "µg/m³"},
+	PM10: {name: "Coarse particulate matter", formula: "PM10", unit: "µg/m³"},
+	O3:   {name: "Ozone", formula: "O₃", unit: "ppb"},
+	NO2:  {name: "Nitrogen dioxide", formula: "NO₂", unit: "ppb"},
+	SO2:  {name: "Sulfur dioxide", formula: "SO₂", unit: "ppb"},
+	CO:   {name: "Carbon monoxide", formula: "CO", unit: "ppm"},
+}
+
+// Valid report whether the pollutant is one of the supported pollutants
+func (p Pollutant) Valid() bool {
+	_, ok := pollutantInfos[p]
+
+	return ok
+}
+
+// Name return the human readable name of the pollutant, or its code if it is unknown
+func (p Pollutant) Name() string {
+	if info, ok := pollutantInfos[p]; ok {
+		return info.name
+	}
+
+	return string(p)
+}
+
+// Formula return the chemical formula of the pollutant, or its code if it is unknown
+func (p Pollutant) Formula() string {
+	if info, ok := pollutantInfos[p]; ok {
+		return info.formula
+	}
+
+	return string(p)
+}
+
+// Unit return the unit AirVisual measures the pollutant concentration in, or an empty string if it is unknown
+func (p Pollutant) Unit() string {
+	return pollutantInfos[p].unit
+}
+
+// Averaging is the averaging period of a concentration
+type Averaging int
+
+// Averaging periods, Default use the period the standard defines for the pollutant
+const (
+	Default Averaging = iota
+	Hour1
+	Hour8
+	Hour24
+	Minute15
+)
+
+// Errors returned when a concentration cannot be converted into an index
+var (
+	ErrUnknownPollutant = errors.New("unknown pollutant")
+	ErrNegative         = errors.New("negative concentration")
+	ErrAveraging        = errors.New("averaging period not supported for pollutant")
+	ErrNotApplicable    = errors.New("concentration outside the range defined for its averaging period")
+	ErrNoConcentration  = errors.New("no concentration")
+)
+
+// Concentration is a measured concentration of a pollutant
+type Concentration struct {
+	Pollutant Pollutant
+	Value     float64
+	Averaging Averaging
+}
+
+// Result contains an overall index and the sub-index of every pollutant that contributed to it
+type Result struct {
+	AQI        int
+	Main       Pollutant   // dominant pollutant, the one with the highest sub-index
+	Primary    []Pollutant // primary pollutants, only set by standards defining them
+	SubIndices map[Pollutant]int
+}
+
+// breakpoint map a concentration range to an index range
+type breakpoint struct {
+	cLow, cHigh float64
+	iLow, iHigh int
+}
+
+// table is a breakpoint table for a pollutant and averaging period
+type table struct {
+	breakpoints []breakpoint
+	step        float64 // concentrations are truncated to a multiple of step, 0 means no truncation
+	scale       float64 // factor applied to the input before truncation, 0 means 1
+	bounded     bool    // concentrations above the table are not applicable instead of capped
+	extrapolate bool    // concentrations above the table extend its last segment instead of being capped
+	roundUp     bool    // indices are rounded up instead of to the nearest integer
+}
+
+func truncate(value, step float64) float64 {
+	if step == 0 {
+		return value
+	}
+
+	return math.Floor(value/step+1e-9) * step
+}
+
+// index interpolate the concentration linearly inside its breakpoint segment,
+// concentrations above an unbounded table are capped at its highest index
+func (t *table) index(value float64) (int, error) {
+	if t.scale != 0 {
+		value *= t.scale
+	}
+	c := truncate(value, t.step)
+
+	first := t.breakpoints[0]
+	if c < first.cLow-1e-9 {
+		return 0, ErrNotApplicable
+	}
+
+	for _, bp := range t.breakpoints {
+		if c <= bp.cHigh+1e-9 {
+			i := float64(bp.iHigh-bp.iLow)/(bp.cHigh-bp.cLow)*(c-bp.cLow) + float64(bp.iLow)
+			if t.roundUp {
+				return int(math.Ceil(i - 1e-9)), nil
+			}
+			return int(math.Round(i)), nil
+		}
+	}
+
+	if t.bounded {
+		return 0, ErrNotApplicable
+	}
+
+	last := t.breakpoints[len(t.breakpoints)-1]
+	if t.extrapolate {
+		i := float64(last.iHigh-last.iLow)/(last.cHigh-last.cLow)*(c-last.cLow) + float64(last.iLow)
+		return int(math.Round(i)), nil
+	}
+
+	return last.iHigh, nil
+}
+
+// subIndices compute the sub-index of every concentration with the given function, keeping the
+// highest applicable one when a pollutant has several concentrations
+func subIndices(concentrations []Concentration, subIndex func(Concentration) (int, error)) (map[Pollutant]int, error) {
+	indices := map[Pollutant]int{}
+	for _, c := range concentrations {
+		i, err := subIndex(c)
+		if errors.Is(err, ErrNotApplicable) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if current, ok := indices[c.Pollutant]; !ok || i > current {
+			indices[c.Pollutant] = i
+		}
+	}
+
+	return indices, nil
+}
+
+// combine pick the highest sub-index as the overall index
+func combine(subIndices map[Pollutant]int) (Result, error) {
+	if len(subIndices) == 0 {
+		return Result{}, ErrNoConcentration
+	}
+
+	result := Result{AQI: -1, SubIndices: subIndices}
+	for _, p := range Pollutants {
+		i, ok := subIndices[p]
+		if ok && i > result.AQI {
+			result.AQI = i
+			result.Main = p
+		}
+	}
+
+	return result, nil
+}
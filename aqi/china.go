@@ -0,0 +1,129 @@
+package aqi
+
+import (
+	"fmt"
+)
+
+// Factors converting the package's gas units, ppb and ppm for CO, into µg/m³ and mg/m³ for CO,
+// at 25°C and 1 atm
+const (
+	o3Factor  = 48.00 / 24.45
+	no2Factor = 46.01 / 24.45
+	so2Factor = 64.07 / 24.45
+	coFactor  = 28.01 / 24.45
+)
+
+// china build a HJ 633-2012 table from its concentration limits of IAQI 0, 50, 100, 150, 200, 300, 400 and 500
+func china(scale float64, bounded bool, limits ...float64) *table {
+	iaqi := []int{0, 50, 100, 150, 200, 300, 400, 500}
+
+	t := &table{scale: scale, bounded: bounded, roundUp: true}
+	for i := 1; i < len(limits); i++ {
+		t.breakpoints = append(t.breakpoints, breakpoint{limits[i-1], limits[i], iaqi[i-1], iaqi[i]})
+	}
+
+	return t
+}
+
+// China MEP HJ 633-2012 breakpoint tables, in µg/m³ and mg/m³ for CO
+var (
+	cnSO224h = china(so2Factor, false, 0, 50, 150, 475, 800, 1600, 2100, 2620)
+	// 1-hour sulfur dioxide above 800 µg/m³ is reported with its 24-hour sub-index instead
+	cnSO21h  = china(so2Factor, true, 0, 150, 500, 650, 800)
+	cnNO224h = china(no2Factor, false, 0, 40, 80, 180, 280, 565, 750, 940)
+	cnNO21h  = china(no2Factor, false, 0, 100, 200, 700, 1200, 2340, 3090, 3840)
+	cnPM10   = china(0, false, 0, 50, 150, 250, 350, 420, 500, 600)
+	cnCO24h  = china(coFactor, false, 0, 2, 4, 14, 24, 36, 48, 60)
+	cnCO1h   = china(coFactor, false, 0, 5, 10, 35, 60, 90, 120, 150)
+	cnO31h   = china(o3Factor, false, 0, 160, 200, 300, 400, 800, 1000, 1200)
+	// 8-hour ozone above 800 µg/m³ is reported with its 1-hour sub-index instead
+	cnO38h = china(o3Factor, true, 0, 100, 160, 215, 265, 800)
+	cnPM25 = china(0, false, 0, 35, 75, 115, 150, 250, 350, 500)
+)
+
+func chinaTable(c Concentration) (*table, error) {
+	switch c.Pollutant {
+	case PM25:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return cnPM25, nil
+		}
+	case PM10:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return cnPM10, nil
+		}
+	case O3:
+		switch c.Averaging {
+		case Default, Hour1:
+			return cnO31h, nil
+		case Hour8:
+			return cnO38h, nil
+		}
+	case NO2:
+		switch c.Averaging {
+		case Default, Hour1:
+			return cnNO21h, nil
+		case Hour24:
+			return cnNO224h, nil
+		}
+	case SO2:
+		switch c.Averaging {
+		case Default, Hour1:
+			return cnSO21h, nil
+		case Hour24:
+			return cnSO224h, nil
+		}
+	case CO:
+		switch c.Averaging {
+		case Default, Hour1:
+			return cnCO1h, nil
+		case Hour24:
+			return cnCO24h, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownPollutant, c.Pollutant)
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrAveraging, c.Pollutant)
+}
+
+// ChinaSubIndex return the China MEP (HJ 633-2012) individual AQI of a concentration.
+// Default averaging periods follow the hourly real-time report: 1 hour for O3, NO2, SO2 and CO
+// and 24 hours for PM2.5 and PM10. Gas concentrations are converted from ppb, or ppm for CO,
+// into the µg/m³ and mg/m³ used by the standard
+func ChinaSubIndex(c Concentration) (int, error) {
+	if c.Value < 0 {
+		return 0, ErrNegative
+	}
+
+	t, err := chinaTable(c)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.index(c.Value)
+}
+
+// China return the China MEP (HJ 633-2012) AQI of the concentrations. Main is the pollutant with
+// the highest individual AQI and Primary list every pollutant sharing it when the AQI is above 50.
+// When a pollutant has several concentrations the highest applicable individual AQI is used
+func China(concentrations ...Concentration) (Result, error) {
+	indices, err := subIndices(concentrations, ChinaSubIndex)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result, err := combine(indices)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if result.AQI > 50 {
+		for _, p := range Pollutants {
+			if i, ok := indices[p]; ok && i == result.AQI {
+				result.Primary = append(result.Primary, p)
+			}
+		}
+	}
+
+	return result, nil
+}
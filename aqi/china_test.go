@@ -0,0 +1,100 @@
+package aqi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestChinaSubIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Concentration
+		want int
+		err  error
+	}{
+		{name: "pm2.5", c: Concentration{PM25, 21, Default}, want: 30},
+		{name: "pm2.5 breakpoint", c: Concentration{PM25, 35, Hour24}, want: 50},
+		{name: "pm2.5 rounded up", c: Concentration{PM25, 80, Default}, want: 107},
+		{name: "pm10", c: Concentration{PM10, 30, Default}, want: 30},
+		{name: "ozone 1 hour", c: Concentration{O3, 48, Default}, want: 30},
+		{name: "ozone 8 hour above range", c: Concentration{O3, 500, Hour8}, err: ErrNotApplicable},
+		{name: "nitrogen dioxide", c: Concentration{NO2, 8, Default}, want: 8},
+		{name: "sulfur dioxide", c: Concentration{SO2, 1, Default}, want: 1},
+		{name: "sulfur dioxide 1 hour above range", c: Concentration{SO2, 400, Hour1}, err: ErrNotApplicable},
+		{name: "sulfur dioxide 24 hour", c: Concentration{SO2, 400, Hour24}, want: 232},
+		{name: "carbon monoxide", c: Concentration{CO, 0.2, Default}, want: 3},
+		{name: "beyond index", c: Concentration{PM25, 900, Default}, want: 500},
+		{name: "unsupported averaging", c: Concentration{PM10, 10, Hour8}, err: ErrAveraging},
+		{name: "negative", c: Concentration{PM10, -10, Default}, err: ErrNegative},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ChinaSubIndex(test.c)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %d , got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestChina(t *testing.T) {
+	tests := []struct {
+		name           string
+		concentrations []Concentration
+		want           Result
+		err            error
+	}{
+		{
+			name: "no primary pollutant below 51",
+			concentrations: []Concentration{
+				{PM25, 21, Default},
+				{PM10, 30, Default},
+				{O3, 48, Default},
+				{NO2, 8, Default},
+			},
+			want: Result{
+				AQI:        30,
+				Main:       PM25,
+				SubIndices: map[Pollutant]int{PM25: 30, PM10: 30, O3: 30, NO2: 8},
+			},
+		},
+		{
+			name: "several primary pollutants",
+			concentrations: []Concentration{
+				{PM25, 80, Default},
+				{PM10, 164, Default},
+				{CO, 0.2, Default},
+			},
+			want: Result{
+				AQI:        107,
+				Main:       PM25,
+				Primary:    []Pollutant{PM25, PM10},
+				SubIndices: map[Pollutant]int{PM25: 107, PM10: 107, CO: 3},
+			},
+		},
+		{
+			name:           "no concentration",
+			concentrations: nil,
+			err:            ErrNoConcentration,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := China(test.concentrations...)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.err == nil && !reflect.DeepEqual(test.want, got) {
+				t.Errorf("expected %#v , got %#v", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package aqi
+
+import (
+	"fmt"
+)
+
+// caqi build a CAQI background grid table from its concentration limits of index 0, 25, 50, 75 and 100,
+// concentrations above the grid extend its last segment
+func caqi(scale float64, limits ...float64) *table {
+	index := []int{0, 25, 50, 75, 100}
+
+	t := &table{scale: scale, extrapolate: true}
+	for i := 1; i < len(limits); i++ {
+		t.breakpoints = append(t.breakpoints, breakpoint{limits[i-1], limits[i], index[i-1], index[i]})
+	}
+
+	return t
+}
+
+// European CAQI background grid, in µg/m³
+var (
+	euNO2    = caqi(no2Factor, 0, 50, 100, 200, 400)
+	euPM101h = caqi(0, 0, 25, 50, 90, 180)
+	euPM1024 = caqi(0, 0, 15, 30, 50, 100)
+	euO3     = caqi(o3Factor, 0, 60, 120, 180, 240)
+	euPM251h = caqi(0, 0, 15, 30, 55, 110)
+	euPM2524 = caqi(0, 0, 10, 20, 30, 60)
+	euCO     = caqi(coFactor*1000, 0, 5000, 7500, 10000, 20000)
+	euSO2    = caqi(so2Factor, 0, 50, 100, 350, 500)
+)
+
+func caqiTable(c Concentration) (*table, error) {
+	switch c.Pollutant {
+	case PM25:
+		switch c.Averaging {
+		case Default, Hour1:
+			return euPM251h, nil
+		case Hour24:
+			return euPM2524, nil
+		}
+	case PM10:
+		switch c.Averaging {
+		case Default, Hour1:
+			return euPM101h, nil
+		case Hour24:
+			return euPM1024, nil
+		}
+	case O3:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return euO3, nil
+		}
+	case NO2:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return euNO2, nil
+		}
+	case SO2:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return euSO2, nil
+		}
+	case CO:
+		if c.Averaging == Default || c.Averaging == Hour8 {
+			return euCO, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownPollutant, c.Pollutant)
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrAveraging, c.Pollutant)
+}
+
+// CAQISubIndex return the European Common Air Quality Index (CAQI) sub-index of a concentration,
+// using the background grid. Default averaging periods are 1 hour, and 8 hours for CO.
+// Indices above 100 are extrapolated from the highest segment of the grid
+func CAQISubIndex(c Concentration) (int, error) {
+	if c.Value < 0 {
+		return 0, ErrNegative
+	}
+
+	t, err := caqiTable(c)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.index(c.Value)
+}
+
+// CAQI return the European Common Air Quality Index of the concentrations
+func CAQI(concentrations ...Concentration) (Result, error) {
+	indices, err := subIndices(concentrations, CAQISubIndex)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return combine(indices)
+}
@@ -0,0 +1,45 @@
+package aqi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCAQISubIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Concentration
+		want int
+		err  error
+	}{
+		{name: "nitrogen dioxide", c: Concentration{NO2, 8, Default}, want: 8},
+		{name: "pm2.5 hourly", c: Concentration{PM25, 21, Default}, want: 35},
+		{name: "pm2.5 daily", c: Concentration{PM25, 21, Hour24}, want: 53},
+		{name: "pm10 above grid", c: Concentration{PM10, 400, Hour1}, want: 161},
+		{name: "unsupported averaging", c: Concentration{O3, 40, Hour8}, err: ErrAveraging},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := CAQISubIndex(test.c)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %d , got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestCAQI(t *testing.T) {
+	got, err := CAQI(Concentration{PM25, 21, Default}, Concentration{NO2, 8, Default})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.AQI != 35 || got.Main != PM25 {
+		t.Errorf("expected 35 p2 , got %d %s", got.AQI, got.Main)
+	}
+}
@@ -0,0 +1,96 @@
+package aqi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInsufficientData is returned when the concentrations do not satisfy the minimum required by a standard
+var ErrInsufficientData = errors.New("insufficient pollutants for index")
+
+// india build a CPCB table from its concentration limits of index 0, 50, 100, 200, 300, 400 and 500
+func india(scale float64, limits ...float64) *table {
+	index := []int{0, 50, 100, 200, 300, 400, 500}
+
+	t := &table{scale: scale}
+	for i := 1; i < len(limits); i++ {
+		t.breakpoints = append(t.breakpoints, breakpoint{limits[i-1], limits[i], index[i-1], index[i]})
+	}
+
+	return t
+}
+
+// India CPCB National AQI breakpoints, in µg/m³ and mg/m³ for CO
+var (
+	inPM10 = india(0, 0, 50, 100, 250, 350, 430, 510)
+	inPM25 = india(0, 0, 30, 60, 90, 120, 250, 380)
+	inNO2  = india(no2Factor, 0, 40, 80, 180, 280, 400, 520)
+	inO3   = india(o3Factor, 0, 50, 100, 168, 208, 748, 1028)
+	inCO   = india(coFactor, 0, 1, 2, 10, 17, 34, 51)
+	inSO2  = india(so2Factor, 0, 40, 80, 380, 800, 1600, 2400)
+)
+
+func indiaTable(c Concentration) (*table, error) {
+	switch c.Pollutant {
+	case PM25:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return inPM25, nil
+		}
+	case PM10:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return inPM10, nil
+		}
+	case O3:
+		if c.Averaging == Default || c.Averaging == Hour8 {
+			return inO3, nil
+		}
+	case NO2:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return inNO2, nil
+		}
+	case SO2:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return inSO2, nil
+		}
+	case CO:
+		if c.Averaging == Default || c.Averaging == Hour8 {
+			return inCO, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownPollutant, c.Pollutant)
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrAveraging, c.Pollutant)
+}
+
+// IndiaSubIndex return the India National AQI sub-index of a concentration. Default averaging
+// periods are 24 hours, and 8 hours for O3 and CO
+func IndiaSubIndex(c Concentration) (int, error) {
+	if c.Value < 0 {
+		return 0, ErrNegative
+	}
+
+	t, err := indiaTable(c)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.index(c.Value)
+}
+
+// India return the India National AQI of the concentrations. As required by CPCB, at least three
+// pollutants including PM2.5 or PM10 are needed, otherwise ErrInsufficientData is returned
+func India(concentrations ...Concentration) (Result, error) {
+	indices, err := subIndices(concentrations, IndiaSubIndex)
+	if err != nil {
+		return Result{}, err
+	}
+
+	_, pm25 := indices[PM25]
+	_, pm10 := indices[PM10]
+	if len(indices) < 3 || !(pm25 || pm10) {
+		return Result{}, ErrInsufficientData
+	}
+
+	return combine(indices)
+}
@@ -0,0 +1,85 @@
+package aqi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIndiaSubIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Concentration
+		want int
+		err  error
+	}{
+		{name: "pm2.5", c: Concentration{PM25, 45, Default}, want: 75},
+		{name: "pm10", c: Concentration{PM10, 120, Hour24}, want: 113},
+		{name: "nitrogen dioxide", c: Concentration{NO2, 30, Default}, want: 71},
+		{name: "beyond index", c: Concentration{PM25, 900, Default}, want: 500},
+		{name: "unsupported averaging", c: Concentration{NO2, 30, Hour1}, err: ErrAveraging},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := IndiaSubIndex(test.c)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %d , got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestIndia(t *testing.T) {
+	tests := []struct {
+		name           string
+		concentrations []Concentration
+		aqi            int
+		main           Pollutant
+		err            error
+	}{
+		{
+			name: "three pollutants",
+			concentrations: []Concentration{
+				{PM25, 45, Default},
+				{PM10, 120, Default},
+				{NO2, 30, Default},
+			},
+			aqi:  113,
+			main: PM10,
+		},
+		{
+			name: "two pollutants",
+			concentrations: []Concentration{
+				{PM25, 45, Default},
+				{NO2, 30, Default},
+			},
+			err: ErrInsufficientData,
+		},
+		{
+			name: "no particulate matter",
+			concentrations: []Concentration{
+				{O3, 20, Default},
+				{NO2, 30, Default},
+				{CO, 0.5, Default},
+			},
+			err: ErrInsufficientData,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := India(test.concentrations...)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.aqi != got.AQI || test.main != got.Main {
+				t.Errorf("expected %d %s , got %d %s", test.aqi, test.main, got.AQI, got.Main)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package aqi
+
+import (
+	"fmt"
+)
+
+// Korea CAI breakpoints, in µg/m³ for particulate matter and ppm for gases
+var (
+	krSO2 = &table{
+		step:  0.001,
+		scale: 0.001,
+		breakpoints: []breakpoint{
+			{0, 0.020, 0, 50},
+			{0.021, 0.050, 51, 100},
+			{0.051, 0.150, 101, 250},
+			{0.151, 1, 251, 500},
+		},
+	}
+	krCO = &table{
+		step: 0.01,
+		breakpoints: []breakpoint{
+			{0, 2, 0, 50},
+			{2.01, 9, 51, 100},
+			{9.01, 15, 101, 250},
+			{15.01, 50, 251, 500},
+		},
+	}
+	krO3 = &table{
+		step:  0.001,
+		scale: 0.001,
+		breakpoints: []breakpoint{
+			{0, 0.030, 0, 50},
+			{0.031, 0.090, 51, 100},
+			{0.091, 0.150, 101, 250},
+			{0.151, 0.600, 251, 500},
+		},
+	}
+	krNO2 = &table{
+		step:  0.001,
+		scale: 0.001,
+		breakpoints: []breakpoint{
+			{0, 0.030, 0, 50},
+			{0.031, 0.060, 51, 100},
+			{0.061, 0.200, 101, 250},
+			{0.201, 2, 251, 500},
+		},
+	}
+	krPM10 = &table{
+		step: 1,
+		breakpoints: []breakpoint{
+			{0, 30, 0, 50},
+			{31, 80, 51, 100},
+			{81, 150, 101, 250},
+			{151, 600, 251, 500},
+		},
+	}
+	krPM25 = &table{
+		step: 1,
+		breakpoints: []breakpoint{
+			{0, 15, 0, 50},
+			{16, 35, 51, 100},
+			{36, 75, 101, 250},
+			{76, 500, 251, 500},
+		},
+	}
+)
+
+func caiTable(c Concentration) (*table, error) {
+	switch c.Pollutant {
+	case PM25:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return krPM25, nil
+		}
+	case PM10:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return krPM10, nil
+		}
+	case O3:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return krO3, nil
+		}
+	case NO2:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return krNO2, nil
+		}
+	case SO2:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return krSO2, nil
+		}
+	case CO:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return krCO, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownPollutant, c.Pollutant)
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrAveraging, c.Pollutant)
+}
+
+// CAISubIndex return the Korea Comprehensive Air-quality Index sub-index of a concentration.
+// Default averaging periods are 24 hours for PM2.5 and PM10 and 1 hour for gases
+func CAISubIndex(c Concentration) (int, error) {
+	if c.Value < 0 {
+		return 0, ErrNegative
+	}
+
+	t, err := caiTable(c)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.index(c.Value)
+}
+
+// CAI return the Korea Comprehensive Air-quality Index of the concentrations. When two pollutants
+// are Unhealthy or worse 50 is added to the index, and 75 when three or more are
+func CAI(concentrations ...Concentration) (Result, error) {
+	indices, err := subIndices(concentrations, CAISubIndex)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result, err := combine(indices)
+	if err != nil {
+		return Result{}, err
+	}
+
+	unhealthy := 0
+	for _, i := range indices {
+		if i > 100 {
+			unhealthy++
+		}
+	}
+	switch {
+	case unhealthy == 2:
+		result.AQI += 50
+	case unhealthy >= 3:
+		result.AQI += 75
+	}
+
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package aqi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCAISubIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Concentration
+		want int
+		err  error
+	}{
+		{name: "pm2.5", c: Concentration{PM25, 20, Default}, want: 61},
+		{name: "pm10", c: Concentration{PM10, 100, Default}, want: 142},
+		{name: "ozone", c: Concentration{O3, 100, Default}, want: 124},
+		{name: "nitrogen dioxide", c: Concentration{NO2, 20, Hour1}, want: 33},
+		{name: "unsupported averaging", c: Concentration{CO, 1, Hour8}, err: ErrAveraging},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := CAISubIndex(test.c)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %d , got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestCAI(t *testing.T) {
+	tests := []struct {
+		name           string
+		concentrations []Concentration
+		aqi            int
+		main           Pollutant
+	}{
+		{
+			name:           "single pollutant",
+			concentrations: []Concentration{{PM25, 20, Default}},
+			aqi:            61,
+			main:           PM25,
+		},
+		{
+			name: "two unhealthy pollutants",
+			concentrations: []Concentration{
+				{PM10, 100, Default},
+				{O3, 100, Default},
+				{NO2, 20, Default},
+			},
+			aqi:  192,
+			main: PM10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := CAI(test.concentrations...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.aqi != got.AQI || test.main != got.Main {
+				t.Errorf("expected %d %s , got %d %s", test.aqi, test.main, got.AQI, got.Main)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package aqi
+
+import (
+	"strings"
+	"sync"
+)
+
+// Category is a band of index values sharing a name, a colour and health advice
+type Category struct {
+	Name             string
+	Color            string // colour as a hex RGB string, e.g. "#00E400"
+	Min              int
+	Max              int    // highest index of the category, the last category also covers indices above it
+	Implication      string // health implications, only set by US EPA and China MEP standards
+	GeneralCaution   string // cautionary statement for the general population
+	SensitiveCaution string // cautionary statement for sensitive groups
+}
+
+// Standard is an air quality index standard
+type Standard struct {
+	Name       string
+	Categories []Category
+	compute    func(concentrations ...Concentration) (Result, error)
+}
+
+// NewStandard return a standard computing its index with the given function
+func NewStandard(name string, categories []Category, compute func(concentrations ...Concentration) (Result, error)) *Standard {
+	return &Standard{
+		Name:       name,
+		Categories: categories,
+		compute:    compute,
+	}
+}
+
+// Compute return the index of the concentrations
+func (s *Standard) Compute(concentrations ...Concentration) (Result, error) {
+	return s.compute(concentrations...)
+}
+
+// Category return the category of an index value, it return false for negative values
+func (s *Standard) Category(index int) (Category, bool) {
+	for _, c := range s.Categories {
+		if index >= c.Min && index <= c.Max {
+			return c, true
+		}
+	}
+
+	last := s.Categories[len(s.Categories)-1]
+	if index > last.Max {
+		return last, true
+	}
+
+	return Category{}, false
+}
+
+// Standards supported by the package
+var (
+	USEPA = NewStandard("US EPA AQI", []Category{
+		{
+			Name:             "Good",
+			Color:            "#00E400",
+			Min:              0,
+			Max:              50,
+			Implication:      "Air quality is satisfactory, and air pollution poses little or no risk.",
+			GeneralCaution:   "None.",
+			SensitiveCaution: "None.",
+		},
+		{
+			Name:             "Moderate",
+			Color:            "#FFFF00",
+			Min:              51,
+			Max:              100,
+			Implication:      "Air quality is acceptable. However, there may be a risk for some people, particularly those who are unusually sensitive to air pollution.",
+			GeneralCaution:   "None.",
+			SensitiveCaution: "Unusually sensitive people should consider reducing prolonged or heavy exertion.",
+		},
+		{
+			Name:             "Unhealthy for Sensitive Groups",
+			Color:            "#FF7E00",
+			Min:              101,
+			Max:              150,
+			Implication:      "Members of sensitive groups may experience health effects. The general public is less likely to be affected.",
+			GeneralCaution:   "None.",
+			SensitiveCaution: "People with heart or lung disease, older adults, children, and people of lower socioeconomic status should reduce prolonged or heavy exertion.",
+		},
+		{
+			Name:             "Unhealthy",
+			Color:            "#FF0000",
+			Min:              151,
+			Max:              200,
+			Implication:      "Some members of the general public may experience health effects; members of sensitive groups may experience more serious health effects.",
+			GeneralCaution:   "Everyone else should reduce prolonged or heavy exertion.",
+			SensitiveCaution: "People with heart or lung disease, older adults, children, and people of lower socioeconomic status should avoid prolonged or heavy exertion.",
+		},
+		{
+			Name:             "Very Unhealthy",
+			Color:            "#8F3F97",
+			Min:              201,
+			Max:              300,
+			Implication:      "Health alert: The risk of health effects is increased for everyone.",
+			GeneralCaution:   "Everyone else should avoid prolonged or heavy exertion.",
+			SensitiveCaution: "People with heart or lung disease, older adults, children, and people of lower socioeconomic status should avoid all physical activity outdoors.",
+		},
+		{
+			Name:             "Hazardous",
+			Color:            "#7E0023",
+			Min:              301,
+			Max:              500,
+			Implication:      "Health warning of emergency conditions: everyone is more likely to be affected.",
+			GeneralCaution:   "Everyone should avoid all physical activity outdoors.",
+			SensitiveCaution: "People with heart or lung disease, older adults, children, and people of lower socioeconomic status should remain indoors and keep activity levels low.",
+		},
+	}, US)
+	ChinaMEP = NewStandard("China MEP AQI (HJ 633-2012)", []Category{
+		{
+			Name:             "Excellent",
+			Color:            "#00E400",
+			Min:              0,
+			Max:              50,
+			Implication:      "Air quality is satisfactory and there is basically no air pollution.",
+			GeneralCaution:   "Everyone can carry on normal activities.",
+			SensitiveCaution: "Everyone can carry on normal activities.",
+		},
+		{
+			Name:             "Good",
+			Color:            "#FFFF00",
+			Min:              51,
+			Max:              100,
+			Implication:      "Air quality is acceptable, but some pollutants may have a weak effect on the health of a very small number of unusually sensitive people.",
+			GeneralCaution:   "Everyone else can carry on normal activities.",
+			SensitiveCaution: "A very small number of unusually sensitive people should reduce outdoor activities.",
+		},
+		{
+			Name:             "Lightly Polluted",
+			Color:            "#FF7E00",
+			Min:              101,
+			Max:              150,
+			Implication:      "Symptoms of susceptible people are slightly aggravated and healthy people show symptoms of irritation.",
+			GeneralCaution:   "None.",
+			SensitiveCaution: "Children, the elderly and people with heart or respiratory disease should reduce prolonged and high-intensity outdoor exercise.",
+		},
+		{
+			Name:             "Moderately Polluted",
+			Color:            "#FF0000",
+			Min:              151,
+			Max:              200,
+			Implication:      "Symptoms of susceptible people are further aggravated and the heart and respiratory system of healthy people may be affected.",
+			GeneralCaution:   "Everyone else should moderately reduce outdoor exercise.",
+			SensitiveCaution: "Children, the elderly and people with heart or respiratory disease should avoid prolonged and high-intensity outdoor exercise.",
+		},
+		{
+			Name:             "Heavily Polluted",
+			Color:            "#99004C",
+			Min:              201,
+			Max:              300,
+			Implication:      "Symptoms of people with heart or lung disease are significantly aggravated and their exercise tolerance is reduced, healthy people commonly show symptoms.",
+			GeneralCaution:   "Everyone else should reduce outdoor exercise.",
+			SensitiveCaution: "Children, the elderly and people with heart or lung disease should stay indoors and stop outdoor exercise.",
+		},
+		{
+			Name:             "Severely Polluted",
+			Color:            "#7E0023",
+			Min:              301,
+			Max:              500,
+			Implication:      "Exercise tolerance of healthy people is reduced, with strong symptoms and early onset of some diseases.",
+			GeneralCaution:   "Everyone else should avoid outdoor activities.",
+			SensitiveCaution: "Children, the elderly and the sick should stay indoors and avoid physical exertion.",
+		},
+	}, China)
+	EUCAQI = NewStandard("European CAQI", []Category{
+		{Name: "Very Low", Color: "#79BC6A", Min: 0, Max: 24},
+		{Name: "Low", Color: "#BBCF4C", Min: 25, Max: 49},
+		{Name: "Medium", Color: "#EEC20B", Min: 50, Max: 74},
+		{Name: "High", Color: "#F29305", Min: 75, Max: 100},
+		{Name: "Very High", Color: "#E8416F", Min: 101, Max: 101},
+	}, CAQI)
+	IndiaNAQI = NewStandard("India National AQI", []Category{
+		{Name: "Good", Color: "#00B050", Min: 0, Max: 50},
+		{Name: "Satisfactory", Color: "#92D050", Min: 51, Max: 100},
+		{Name: "Moderate", Color: "#FFFF00", Min: 101, Max: 200},
+		{Name: "Poor", Color: "#FF9900", Min: 201, Max: 300},
+		{Name: "Very Poor", Color: "#FF0000", Min: 301, Max: 400},
+		{Name: "Severe", Color: "#C00000", Min: 401, Max: 500},
+	}, India)
+	UKDAQI = NewStandard("UK Daily Air Quality Index", []Category{
+		{Name: "Low", Color: "#9CFF9C", Min: 1, Max: 1},
+		{Name: "Low", Color: "#31FF00", Min: 2, Max: 2},
+		{Name: "Low", Color: "#31CF00", Min: 3, Max: 3},
+		{Name: "Moderate", Color: "#FFFF00", Min: 4, Max: 4},
+		{Name: "Moderate", Color: "#FFCF00", Min: 5, Max: 5},
+		{Name: "Moderate", Color: "#FF9A00", Min: 6, Max: 6},
+		{Name: "High", Color: "#FF6464", Min: 7, Max: 7},
+		{Name: "High", Color: "#FF0000", Min: 8, Max: 8},
+		{Name: "High", Color: "#990000", Min: 9, Max: 9},
+		{Name: "Very High", Color: "#CE30FF", Min: 10, Max: 10},
+	}, DAQI)
+	KoreaCAI = NewStandard("Korea Comprehensive Air-quality Index", []Category{
+		{Name: "Good", Color: "#32A1FF", Min: 0, Max: 50},
+		{Name: "Moderate", Color: "#00C73C", Min: 51, Max: 100},
+		{Name: "Unhealthy", Color: "#FDA60E", Min: 101, Max: 250},
+		{Name: "Very Unhealthy", Color: "#E64746", Min: 251, Max: 500},
+	}, CAI)
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*Standard{}
+)
+
+func init() {
+	Register("USA", USEPA)
+	Register("China", ChinaMEP)
+	Register("India", IndiaNAQI)
+	Register("United Kingdom", UKDAQI)
+	Register("South Korea", KoreaCAI)
+
+	for _, country := range []string{
+		"Austria", "Belgium", "Bulgaria", "Croatia", "Cyprus", "Czech Republic", "Denmark",
+		"Estonia", "Finland", "France", "Germany", "Greece", "Hungary", "Ireland", "Italy",
+		"Latvia", "Lithuania", "Luxembourg", "Malta", "Netherlands", "Poland", "Portugal",
+		"Romania", "Slovakia", "Slovenia", "Spain", "Sweden",
+	} {
+		Register(country, EUCAQI)
+	}
+}
+
+// Register set the standard used for a country, named as in AirVisual's Country field
+func Register(country string, standard *Standard) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[strings.ToLower(country)] = standard
+}
+
+// ForCountry return the standard used by a country, named as in AirVisual's Country field,
+// or the US EPA standard when none is registered
+func ForCountry(country string) *Standard {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	if standard, ok := registry[strings.ToLower(country)]; ok {
+		return standard
+	}
+
+	return USEPA
+}
@@ -0,0 +1,70 @@
+package aqi
+
+import (
+	"testing"
+)
+
+func TestStandardCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		standard *Standard
+		index    int
+		want     string
+		ok       bool
+	}{
+		{name: "us good", standard: USEPA, index: 0, want: "Good", ok: true},
+		{name: "us sensitive groups", standard: USEPA, index: 120, want: "Unhealthy for Sensitive Groups", ok: true},
+		{name: "us beyond index", standard: USEPA, index: 650, want: "Hazardous", ok: true},
+		{name: "china lightly polluted", standard: ChinaMEP, index: 101, want: "Lightly Polluted", ok: true},
+		{name: "caqi very high", standard: EUCAQI, index: 150, want: "Very High", ok: true},
+		{name: "india poor", standard: IndiaNAQI, index: 250, want: "Poor", ok: true},
+		{name: "uk band below index", standard: UKDAQI, index: 0, ok: false},
+		{name: "korea unhealthy", standard: KoreaCAI, index: 192, want: "Unhealthy", ok: true},
+		{name: "negative", standard: USEPA, index: -1, ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := test.standard.Category(test.index)
+
+			if test.ok != ok {
+				t.Errorf("expected %v , got %v", test.ok, ok)
+			}
+			if test.want != got.Name {
+				t.Errorf("expected %s , got %s", test.want, got.Name)
+			}
+		})
+	}
+}
+
+func TestForCountry(t *testing.T) {
+	tests := []struct {
+		country string
+		want    *Standard
+	}{
+		{country: "USA", want: USEPA},
+		{country: "China", want: ChinaMEP},
+		{country: "germany", want: EUCAQI},
+		{country: "India", want: IndiaNAQI},
+		{country: "United Kingdom", want: UKDAQI},
+		{country: "South Korea", want: KoreaCAI},
+		{country: "Japan", want: USEPA},
+	}
+
+	for _, test := range tests {
+		t.Run(test.country, func(t *testing.T) {
+			got := ForCountry(test.country)
+
+			if test.want != got {
+				t.Errorf("expected %s , got %s", test.want.Name, got.Name)
+			}
+		})
+	}
+
+	custom := NewStandard("Custom", USEPA.Categories, US)
+	Register("Atlantis", custom)
+
+	if got := ForCountry("Atlantis"); got != custom {
+		t.Errorf("expected %s , got %s", custom.Name, got.Name)
+	}
+}
@@ -0,0 +1,86 @@
+package aqi
+
+import (
+	"fmt"
+	"math"
+)
+
+// UK DAQI upper limits of bands 1 to 9 in µg/m³, concentrations above them are in band 10
+var (
+	ukO3   = []float64{33, 66, 100, 120, 140, 160, 187, 213, 240}
+	ukNO2  = []float64{67, 134, 200, 267, 334, 400, 467, 534, 600}
+	ukSO2  = []float64{88, 177, 266, 354, 443, 532, 710, 887, 1064}
+	ukPM25 = []float64{11, 23, 35, 41, 47, 53, 58, 64, 70}
+	ukPM10 = []float64{16, 33, 50, 58, 66, 75, 83, 91, 100}
+)
+
+func daqiBands(c Concentration) ([]float64, float64, error) {
+	switch c.Pollutant {
+	case PM25:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return ukPM25, 1, nil
+		}
+	case PM10:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return ukPM10, 1, nil
+		}
+	case O3:
+		if c.Averaging == Default || c.Averaging == Hour8 {
+			return ukO3, o3Factor, nil
+		}
+	case NO2:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return ukNO2, no2Factor, nil
+		}
+	case SO2:
+		if c.Averaging == Default || c.Averaging == Minute15 {
+			return ukSO2, so2Factor, nil
+		}
+	case CO:
+		return nil, 0, fmt.Errorf("%w %s", ErrAveraging, c.Pollutant)
+	default:
+		return nil, 0, fmt.Errorf("%w %q", ErrUnknownPollutant, c.Pollutant)
+	}
+
+	return nil, 0, fmt.Errorf("%w %s", ErrAveraging, c.Pollutant)
+}
+
+// DAQISubIndex return the UK Daily Air Quality Index band, from 1 to 10, of a concentration.
+// Default averaging periods are 24 hours for PM2.5 and PM10, 8 hours for O3, 1 hour for NO2
+// and 15 minutes for SO2. CO is not part of the index
+func DAQISubIndex(c Concentration) (int, error) {
+	if c.Value < 0 {
+		return 0, ErrNegative
+	}
+
+	bands, scale, err := daqiBands(c)
+	if err != nil {
+		return 0, err
+	}
+
+	value := math.Round(c.Value * scale)
+	for i, limit := range bands {
+		if value <= limit {
+			return i + 1, nil
+		}
+	}
+
+	return len(bands) + 1, nil
+}
+
+// DAQI return the UK Daily Air Quality Index of the concentrations, CO concentrations are ignored
+func DAQI(concentrations ...Concentration) (Result, error) {
+	var filtered []Concentration
+	for _, c := range concentrations {
+		if c.Pollutant != CO {
+			filtered = append(filtered, c)
+		}
+	}
+
+	indices, err := subIndices(filtered, DAQISubIndex)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return combine(indices)
+}
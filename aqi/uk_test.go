@@ -0,0 +1,48 @@
+package aqi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDAQISubIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Concentration
+		want int
+		err  error
+	}{
+		{name: "pm2.5 band 1", c: Concentration{PM25, 11, Default}, want: 1},
+		{name: "pm2.5 band 2", c: Concentration{PM25, 12, Default}, want: 2},
+		{name: "pm2.5 band 4", c: Concentration{PM25, 36, Hour24}, want: 4},
+		{name: "pm2.5 band 10", c: Concentration{PM25, 71, Default}, want: 10},
+		{name: "ozone", c: Concentration{O3, 50, Default}, want: 3},
+		{name: "nitrogen dioxide", c: Concentration{NO2, 8, Default}, want: 1},
+		{name: "carbon monoxide", c: Concentration{CO, 1, Default}, err: ErrAveraging},
+		{name: "unsupported averaging", c: Concentration{SO2, 10, Hour1}, err: ErrAveraging},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := DAQISubIndex(test.c)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %d , got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestDAQI(t *testing.T) {
+	got, err := DAQI(Concentration{PM25, 36, Default}, Concentration{CO, 1, Default})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.AQI != 4 || got.Main != PM25 {
+		t.Errorf("expected 4 p2 , got %d %s", got.AQI, got.Main)
+	}
+}
@@ -0,0 +1,162 @@
+package aqi
+
+import (
+	"fmt"
+)
+
+// US EPA breakpoint tables, including the 2024 revision of PM2.5 and PM10
+var (
+	usPM25 = &table{
+		step: 0.1,
+		breakpoints: []breakpoint{
+			{0.0, 9.0, 0, 50},
+			{9.1, 35.4, 51, 100},
+			{35.5, 55.4, 101, 150},
+			{55.5, 125.4, 151, 200},
+			{125.5, 225.4, 201, 300},
+			{225.5, 325.4, 301, 500},
+		},
+	}
+	usPM10 = &table{
+		step: 1,
+		breakpoints: []breakpoint{
+			{0, 54, 0, 50},
+			{55, 154, 51, 100},
+			{155, 254, 101, 150},
+			{255, 354, 151, 200},
+			{355, 424, 201, 300},
+			{425, 604, 301, 500},
+		},
+	}
+	// 8-hour ozone does not define indices above 300, 1-hour ozone is used instead
+	usO38h = &table{
+		step:    0.001,
+		scale:   0.001,
+		bounded: true,
+		breakpoints: []breakpoint{
+			{0.000, 0.054, 0, 50},
+			{0.055, 0.070, 51, 100},
+			{0.071, 0.085, 101, 150},
+			{0.086, 0.105, 151, 200},
+			{0.106, 0.200, 201, 300},
+		},
+	}
+	// 1-hour ozone does not define indices below 101
+	usO31h = &table{
+		step:  0.001,
+		scale: 0.001,
+		breakpoints: []breakpoint{
+			{0.125, 0.164, 101, 150},
+			{0.165, 0.204, 151, 200},
+			{0.205, 0.404, 201, 300},
+			{0.405, 0.604, 301, 500},
+		},
+	}
+	usCO = &table{
+		step: 0.1,
+		breakpoints: []breakpoint{
+			{0.0, 4.4, 0, 50},
+			{4.5, 9.4, 51, 100},
+			{9.5, 12.4, 101, 150},
+			{12.5, 15.4, 151, 200},
+			{15.5, 30.4, 201, 300},
+			{30.5, 50.4, 301, 500},
+		},
+	}
+	// 1-hour sulfur dioxide does not define indices above 200, 24-hour sulfur dioxide is used instead
+	usSO21h = &table{
+		step: 1,
+		breakpoints: []breakpoint{
+			{0, 35, 0, 50},
+			{36, 75, 51, 100},
+			{76, 185, 101, 150},
+			{186, 304, 151, 200},
+		},
+	}
+	// 24-hour sulfur dioxide does not define indices below 201
+	usSO224h = &table{
+		step: 1,
+		breakpoints: []breakpoint{
+			{305, 604, 201, 300},
+			{605, 1004, 301, 500},
+		},
+	}
+	usNO2 = &table{
+		step: 1,
+		breakpoints: []breakpoint{
+			{0, 53, 0, 50},
+			{54, 100, 51, 100},
+			{101, 360, 101, 150},
+			{361, 649, 151, 200},
+			{650, 1249, 201, 300},
+			{1250, 2049, 301, 500},
+		},
+	}
+)
+
+func usTable(c Concentration) (*table, error) {
+	switch c.Pollutant {
+	case PM25:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return usPM25, nil
+		}
+	case PM10:
+		if c.Averaging == Default || c.Averaging == Hour24 {
+			return usPM10, nil
+		}
+	case O3:
+		switch c.Averaging {
+		case Default, Hour8:
+			return usO38h, nil
+		case Hour1:
+			return usO31h, nil
+		}
+	case NO2:
+		if c.Averaging == Default || c.Averaging == Hour1 {
+			return usNO2, nil
+		}
+	case SO2:
+		switch c.Averaging {
+		case Default, Hour1:
+			return usSO21h, nil
+		case Hour24:
+			return usSO224h, nil
+		}
+	case CO:
+		if c.Averaging == Default || c.Averaging == Hour8 {
+			return usCO, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownPollutant, c.Pollutant)
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrAveraging, c.Pollutant)
+}
+
+// USSubIndex return the US EPA sub-index of a concentration. Default averaging periods are
+// 24 hours for PM2.5 and PM10, 8 hours for O3 and CO and 1 hour for NO2 and SO2.
+// Concentrations are truncated as specified by EPA before the index is interpolated
+func USSubIndex(c Concentration) (int, error) {
+	if c.Value < 0 {
+		return 0, ErrNegative
+	}
+
+	t, err := usTable(c)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.index(c.Value)
+}
+
+// US return the US EPA AQI of the concentrations and its dominant pollutant.
+// When a pollutant has several concentrations, e.g. 1-hour and 8-hour ozone, the highest
+// applicable sub-index is used and concentrations outside of their averaging period range are ignored
+func US(concentrations ...Concentration) (Result, error) {
+	indices, err := subIndices(concentrations, USSubIndex)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return combine(indices)
+}
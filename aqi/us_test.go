@@ -0,0 +1,114 @@
+package aqi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUSSubIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Concentration
+		want int
+		err  error
+	}{
+		{name: "pm2.5 good upper bound", c: Concentration{PM25, 9.0, Default}, want: 50},
+		{name: "pm2.5 truncated", c: Concentration{PM25, 9.09, Hour24}, want: 50},
+		{name: "pm2.5 moderate", c: Concentration{PM25, 12.0, Default}, want: 56},
+		{name: "pm2.5 moderate upper bound", c: Concentration{PM25, 35.4, Default}, want: 100},
+		{name: "pm2.5 beyond index", c: Concentration{PM25, 500, Default}, want: 500},
+		{name: "pm10 moderate", c: Concentration{PM10, 100.9, Default}, want: 73},
+		{name: "ozone 8 hour", c: Concentration{O3, 48, Default}, want: 44},
+		{name: "ozone 8 hour upper bound", c: Concentration{O3, 70, Hour8}, want: 100},
+		{name: "ozone 8 hour above range", c: Concentration{O3, 250, Hour8}, err: ErrNotApplicable},
+		{name: "ozone 1 hour below range", c: Concentration{O3, 100, Hour1}, err: ErrNotApplicable},
+		{name: "ozone 1 hour", c: Concentration{O3, 150, Hour1}, want: 132},
+		{name: "nitrogen dioxide", c: Concentration{NO2, 8, Default}, want: 8},
+		{name: "sulfur dioxide 1 hour capped", c: Concentration{SO2, 400, Hour1}, want: 200},
+		{name: "sulfur dioxide 24 hour below range", c: Concentration{SO2, 200, Hour24}, err: ErrNotApplicable},
+		{name: "sulfur dioxide 24 hour", c: Concentration{SO2, 400, Hour24}, want: 232},
+		{name: "carbon monoxide", c: Concentration{CO, 0.2, Default}, want: 2},
+		{name: "negative", c: Concentration{CO, -1, Default}, err: ErrNegative},
+		{name: "unsupported averaging", c: Concentration{PM25, 10, Hour1}, err: ErrAveraging},
+		{name: "unknown pollutant", c: Concentration{"xx", 10, Default}, err: ErrUnknownPollutant},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := USSubIndex(test.c)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %d , got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestUS(t *testing.T) {
+	tests := []struct {
+		name           string
+		concentrations []Concentration
+		want           Result
+		err            error
+	}{
+		{
+			name: "dominant particulate matter",
+			concentrations: []Concentration{
+				{PM25, 21, Default},
+				{PM10, 30, Default},
+				{O3, 48, Hour8},
+				{O3, 60, Hour1},
+				{NO2, 8, Default},
+				{SO2, 1, Default},
+				{CO, 0.2, Default},
+			},
+			want: Result{
+				AQI:  73,
+				Main: PM25,
+				SubIndices: map[Pollutant]int{
+					PM25: 73,
+					PM10: 28,
+					O3:   44,
+					NO2:  8,
+					SO2:  1,
+					CO:   2,
+				},
+			},
+		},
+		{
+			name: "1 hour ozone more precautionary",
+			concentrations: []Concentration{
+				{O3, 60, Hour8},
+				{O3, 150, Hour1},
+				{PM25, 12, Default},
+			},
+			want: Result{
+				AQI:        132,
+				Main:       O3,
+				SubIndices: map[Pollutant]int{O3: 132, PM25: 56},
+			},
+		},
+		{
+			name:           "no applicable concentration",
+			concentrations: []Concentration{{O3, 60, Hour1}},
+			err:            ErrNoConcentration,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := US(test.concentrations...)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.err == nil && !reflect.DeepEqual(test.want, got) {
+				t.Errorf("expected %#v , got %#v", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package airvisual
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultConcurrency is the number of concurrent requests of bulk fetches when FetchOptions.Concurrency is not set
+const DefaultConcurrency = 4
+
+// CityRef identify a city
+type CityRef struct {
+	City    string
+	State   string
+	Country string
+}
+
+// StationRef identify a station
+type StationRef struct {
+	Station string
+	City    string
+	State   string
+	Country string
+}
+
+// FetchOptions configure bulk fetches
+type FetchOptions struct {
+	Concurrency int // maximum number of concurrent requests, defaults to DefaultConcurrency
+}
+
+// CityResult contains a city fetched by FetchCities or the error that prevented fetching it
+type CityResult struct {
+	Ref  CityRef
+	City *City
+	Err  error
+}
+
+// StationResult contains a station fetched by FetchStations or the error that prevented fetching it
+type StationResult struct {
+	Ref     StationRef
+	Station *Station
+	Err     error
+}
+
+// FetchCities fetch many cities from api concurrently, the results are in the order of refs and failed cities have
+// Err set, requests made with a Client go through its rate limiter, retry policy and cache like any other call
+func FetchCities(ctx context.Context, api API, refs []CityRef, opts FetchOptions) []CityResult {
+	results := make([]CityResult, len(refs))
+	parallel(len(refs), opts.Concurrency, func(i int) {
+		results[i].Ref = refs[i]
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			return
+		}
+		results[i].City, results[i].Err = api.CityContext(ctx, refs[i].City, refs[i].State, refs[i].Country)
+	})
+
+	return results
+}
+
+// FetchStations fetch many stations from api concurrently, the results are in the order of refs and failed stations
+// have Err set, requests made with a Client go through its rate limiter, retry policy and cache like any other call
+func FetchStations(ctx context.Context, api API, refs []StationRef, opts FetchOptions) []StationResult {
+	results := make([]StationResult, len(refs))
+	parallel(len(refs), opts.Concurrency, func(i int) {
+		results[i].Ref = refs[i]
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			return
+		}
+		results[i].Station, results[i].Err = api.StationContext(ctx, refs[i].Station, refs[i].City, refs[i].State, refs[i].Country)
+	})
+
+	return results
+}
+
+// parallel call fn for every index below n using at most concurrency goroutines
+func parallel(n, concurrency int, fn func(i int)) {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	if concurrency > n {
+		concurrency = n
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+}
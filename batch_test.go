@@ -0,0 +1,138 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func mockBatchServer(inFlight, maxInFlight *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(inFlight, 1)
+		defer atomic.AddInt32(inFlight, -1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		q := r.URL.Query()
+		if q.Get("city") == "Nowhere" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":"fail","data":{"message":"city_not_found"}}`))
+			return
+		}
+		fmt.Fprintf(w, `{"status":"success","data":{"name":%q,"city":%q,"state":%q,"country":%q}}`,
+			q.Get("station"), q.Get("city"), q.Get("state"), q.Get("country"))
+	}))
+}
+
+func TestFetchCities(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := mockBatchServer(&inFlight, &maxInFlight)
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL))
+
+	var refs []CityRef
+	for i := 0; i < 10; i++ {
+		refs = append(refs, CityRef{City: fmt.Sprintf("City %d", i), State: "State", Country: "Country"})
+	}
+	refs[3].City = "Nowhere"
+
+	results := FetchCities(context.Background(), client, refs, FetchOptions{Concurrency: 3})
+
+	if len(results) != len(refs) {
+		t.Fatalf("expected %d results , got %d", len(refs), len(results))
+	}
+	for i, result := range results {
+		if result.Ref != refs[i] {
+			t.Errorf("expected ref %v at %d , got %v", refs[i], i, result.Ref)
+		}
+		if i == 3 {
+			if !errors.Is(result.Err, ErrCityNotFound) || result.City != nil {
+				t.Errorf("expected %v , got %v %v", ErrCityNotFound, result.City, result.Err)
+			}
+			continue
+		}
+		if result.Err != nil || result.City == nil || result.City.City != refs[i].City {
+			t.Errorf("expected %s , got %v %v", refs[i].City, result.City, result.Err)
+		}
+	}
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 concurrent requests , got %d", maxInFlight)
+	}
+}
+
+func TestFetchStations(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := mockBatchServer(&inFlight, &maxInFlight)
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL))
+
+	refs := []StationRef{
+		{Station: "US Embassy in Beijing", City: "Beijing", State: "Beijing", Country: "China"},
+		{Station: "Dongsi", City: "Beijing", State: "Beijing", Country: "China"},
+	}
+
+	results := FetchStations(context.Background(), client, refs, FetchOptions{})
+
+	for i, result := range results {
+		if result.Err != nil || result.Station == nil || result.Station.Name != refs[i].Station {
+			t.Errorf("expected %s , got %v %v", refs[i].Station, result.Station, result.Err)
+		}
+	}
+}
+
+func TestFetchCitiesRateLimit(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := mockBatchServer(&inFlight, &maxInFlight)
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL), WithRateLimit(RateLimit{PerMinute: 2}))
+
+	refs := []CityRef{{City: "A"}, {City: "B"}, {City: "C"}, {City: "D"}}
+	results := FetchCities(context.Background(), client, refs, FetchOptions{Concurrency: 4})
+
+	succeeded, limited := 0, 0
+	for _, result := range results {
+		switch {
+		case result.Err == nil:
+			succeeded++
+		case errors.Is(result.Err, ErrRateLimitExceeded):
+			limited++
+		}
+	}
+	if succeeded != 2 || limited != 2 {
+		t.Errorf("expected 2 fetched and 2 rate limited cities , got %d and %d", succeeded, limited)
+	}
+}
+
+func TestFetchCitiesCanceled(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := mockBatchServer(&inFlight, &maxInFlight)
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := FetchCities(ctx, client, []CityRef{{City: "A"}, {City: "B"}}, FetchOptions{})
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("expected %v , got %v", context.Canceled, result.Err)
+		}
+	}
+	if results := FetchCities(ctx, client, nil, FetchOptions{}); len(results) != 0 {
+		t.Errorf("expected no results , got %d", len(results))
+	}
+}
@@ -0,0 +1,311 @@
+package airvisual
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache stores raw API responses, an entry must not be returned after it expires
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, expires time.Time)
+}
+
+// CacheTTL contains how long the response of each endpoint is cached, 0 disables caching of that endpoint.
+// City and station data expire relative to the timestamp of their current pollution reading
+type CacheTTL struct {
+	Countries      time.Duration
+	States         time.Duration
+	Cities         time.Duration
+	Stations       time.Duration
+	City           time.Duration
+	NearestCity    time.Duration
+	Station        time.Duration
+	NearestStation time.Duration
+	CityRanking    time.Duration
+	// Stale is used instead when a reading is already older than its TTL,
+	// so a late update from AirVisual is picked up soon
+	Stale time.Duration
+}
+
+// DefaultCacheTTL return TTLs matching AirVisual's hourly update cadence
+func DefaultCacheTTL() CacheTTL {
+	return CacheTTL{
+		Countries:      24 * time.Hour,
+		States:         24 * time.Hour,
+		Cities:         24 * time.Hour,
+		Stations:       24 * time.Hour,
+		City:           time.Hour,
+		NearestCity:    time.Hour,
+		Station:        time.Hour,
+		NearestStation: time.Hour,
+		CityRanking:    time.Hour,
+		Stale:          5 * time.Minute,
+	}
+}
+
+// WithCache set response cache on airvisual client
+func WithCache(cache Cache, ttl CacheTTL) Option {
+	return func(c *Client) {
+		c.cache = &responseCache{
+			cache: cache,
+			ttl:   ttl,
+			now:   time.Now,
+		}
+	}
+}
+
+type responseCache struct {
+	cache Cache
+	ttl   CacheTTL
+	now   func() time.Time
+}
+
+func (r *responseCache) key(api string, v url.Values) string {
+	params := url.Values{}
+	for name, values := range v {
+		if name != "key" {
+			params[name] = values
+		}
+	}
+
+	return api + "?" + params.Encode()
+}
+
+func (r *responseCache) lookup(api string, v url.Values) ([]byte, bool) {
+	if r.ttlOf(api) <= 0 {
+		return nil, false
+	}
+
+	return r.cache.Get(r.key(api, v))
+}
+
+func (r *responseCache) store(api string, v url.Values, body []byte) {
+	ttl := r.ttlOf(api)
+	if ttl <= 0 {
+		return
+	}
+
+	now := r.now()
+	expires := now.Add(ttl)
+
+	switch api {
+	case cityEndpoint, nearestCityEndpoint, stationEndpoint, nearestStationEndpoint:
+		ts, ok := readingTime(body)
+		if !ok {
+			break
+		}
+		if next := ts.Add(ttl); next.After(now) {
+			if next.Before(expires) {
+				expires = next
+			}
+		} else if r.ttl.Stale > 0 {
+			expires = now.Add(r.ttl.Stale)
+		}
+	}
+
+	r.cache.Set(r.key(api, v), body, expires)
+}
+
+func (r *responseCache) ttlOf(api string) time.Duration {
+	switch api {
+	case countriesEndpoint:
+		return r.ttl.Countries
+	case statesEndpoint:
+		return r.ttl.States
+	case citiesEndpoint:
+		return r.ttl.Cities
+	case stationsEndpoint:
+		return r.ttl.Stations
+	case cityEndpoint:
+		return r.ttl.City
+	case nearestCityEndpoint:
+		return r.ttl.NearestCity
+	case stationEndpoint:
+		return r.ttl.Station
+	case nearestStationEndpoint:
+		return r.ttl.NearestStation
+	case cityRankingEndpoint:
+		return r.ttl.CityRanking
+	}
+
+	return 0
+}
+
+// readingTime return timestamp of the current pollution reading in a response body
+func readingTime(body []byte) (time.Time, bool) {
+	payload := struct {
+		Data struct {
+			Current struct {
+				Pollution struct {
+					TS Timestamp `json:"ts"`
+				} `json:"pollution"`
+			} `json:"current"`
+		} `json:"data"`
+	}{}
+	if json.Unmarshal(body, &payload) != nil {
+		return time.Time{}, false
+	}
+
+	ts := payload.Data.Current.Pollution.TS
+
+	return ts.Time, !ts.IsZero()
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entry when it is full
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache return a MemoryCache holding at most capacity entries, 0 means unbounded
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get return cached value of the key if it is not expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*memoryEntry)
+	if !m.now().Before(entry.expires) {
+		m.order.Remove(element)
+		delete(m.entries, key)
+		return nil, false
+	}
+
+	m.order.MoveToFront(element)
+
+	return entry.value, true
+}
+
+// Set cache value of the key until it expires
+func (m *MemoryCache) Set(key string, value []byte, expires time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		entry.value = value
+		entry.expires = expires
+		m.order.MoveToFront(element)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, value: value, expires: expires})
+
+	for m.capacity > 0 && m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryEntry).key)
+	}
+}
+
+// Len return number of entries in the cache, including expired ones not yet evicted
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+// DiskCache is a Cache storing each entry as a file inside a directory
+type DiskCache struct {
+	dir string
+	now func() time.Time
+}
+
+type diskEntry struct {
+	Expires time.Time `json:"expires"`
+	Value   []byte    `json:"value"`
+}
+
+// NewDiskCache return a DiskCache storing entries inside dir, creating it if needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create cache directory: %w", err)
+	}
+
+	return &DiskCache{dir: dir, now: time.Now}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get return cached value of the key if it is not expired
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	data, err := ioutil.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	entry := diskEntry{}
+	if json.Unmarshal(data, &entry) != nil {
+		return nil, false
+	}
+	if !d.now().Before(entry.Expires) {
+		os.Remove(d.path(key))
+		return nil, false
+	}
+
+	return entry.Value, true
+}
+
+// Set cache value of the key until it expires, write failures are ignored
+func (d *DiskCache) Set(key string, value []byte, expires time.Time) {
+	data, err := json.Marshal(diskEntry{Expires: expires, Value: value})
+	if err != nil {
+		return
+	}
+
+	tmp, err := ioutil.TempFile(d.dir, "entry-*")
+	if err != nil {
+		return
+	}
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+
+	if os.Rename(tmp.Name(), d.path(key)) != nil {
+		os.Remove(tmp.Name())
+	}
+}
@@ -0,0 +1,155 @@
+package airvisual
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type recordingCache struct {
+	*MemoryCache
+	expires map[string]time.Time
+}
+
+func (r *recordingCache) Set(key string, value []byte, expires time.Time) {
+	r.expires[key] = expires
+	r.MemoryCache.Set(key, value, expires)
+}
+
+func TestMemoryCache(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)}
+	cache := NewMemoryCache(2)
+	cache.now = clock.Now
+
+	cache.Set("a", []byte("1"), clock.now.Add(time.Hour))
+	cache.Set("b", []byte("2"), clock.now.Add(time.Minute))
+	cache.Get("a")
+	cache.Set("c", []byte("3"), clock.now.Add(time.Hour))
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected least recently used entry to be evicted")
+	}
+	if got, ok := cache.Get("a"); !ok || string(got) != "1" {
+		t.Errorf("expected %s , got %s", "1", got)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+
+	if _, ok := cache.Get("c"); ok {
+		t.Errorf("expected expired entry to be missing")
+	}
+	if cache.Len() != 1 {
+		t.Errorf("expected %d entries , got %d", 1, cache.Len())
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "airvisual")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	clock := &fakeClock{now: time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)}
+	cache, err := NewDiskCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.now = clock.Now
+
+	cache.Set("/v2/countries?", []byte(`{"status":"success"}`), clock.now.Add(time.Hour))
+
+	got, ok := cache.Get("/v2/countries?")
+	if !ok || string(got) != `{"status":"success"}` {
+		t.Errorf("expected %s , got %s", `{"status":"success"}`, got)
+	}
+	if _, ok := cache.Get("/v2/states?"); ok {
+		t.Errorf("expected missing entry")
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+
+	if _, ok := cache.Get("/v2/countries?"); ok {
+		t.Errorf("expected expired entry to be missing")
+	}
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	now := time.Date(2019, 8, 4, 19, 20, 0, 0, time.UTC)
+	reading := func(ts string) []byte {
+		return []byte(`{"status":"success","data":{"current":{"pollution":{"ts":"` + ts + `"}}}}`)
+	}
+
+	tests := []struct {
+		name string
+		api  string
+		body []byte
+		want time.Time
+	}{
+		{
+			name: "catalog uses wall clock",
+			api:  countriesEndpoint,
+			body: []byte(`{"status":"success","data":[]}`),
+			want: now.Add(24 * time.Hour),
+		},
+		{
+			name: "reading expires an hour after its timestamp",
+			api:  cityEndpoint,
+			body: reading("2019-08-04T19:00:00.000Z"),
+			want: time.Date(2019, 8, 4, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "stale reading expires soon",
+			api:  stationEndpoint,
+			body: reading("2019-08-04T17:00:00.000Z"),
+			want: now.Add(5 * time.Minute),
+		},
+		{
+			name: "reading without timestamp uses wall clock",
+			api:  nearestCityEndpoint,
+			body: reading(""),
+			want: now.Add(time.Hour),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache := &recordingCache{MemoryCache: NewMemoryCache(0), expires: map[string]time.Time{}}
+			r := &responseCache{cache: cache, ttl: DefaultCacheTTL(), now: func() time.Time { return now }}
+			v := url.Values{"key": {"API Key"}, "city": {"Los Angeles"}}
+
+			r.store(test.api, v, test.body)
+
+			got := cache.expires[test.api+"?city=Los+Angeles"]
+			if !test.want.Equal(got) {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestClientCache(t *testing.T) {
+	client, server, calls := mockSequenceServer(
+		[]mockResponse{{status: http.StatusOK, body: `{"status": "success", "data": [{"country": "USA"}]}`}},
+		WithCache(NewMemoryCache(10), DefaultCacheTTL()),
+	)
+	defer server.Close()
+
+	for i := 0; i < 3; i++ {
+		got, err := client.Countries()
+		if err != nil {
+			t.Fatalf("expected no error , got %v", err)
+		}
+		if len(got) != 1 || got[0].Country != "USA" {
+			t.Errorf("expected %s , got %#v", "USA", got)
+		}
+	}
+
+	if atomic.LoadInt32(calls) != 1 {
+		t.Errorf("expected 1 call , got %d", atomic.LoadInt32(calls))
+	}
+}
@@ -0,0 +1,324 @@
+package airvisual
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Catalog is the tree of locations supported by AirVisual
+type Catalog struct {
+	Countries []*CatalogCountry `json:"countries"`
+}
+
+// CatalogCountry is a country of the catalog
+type CatalogCountry struct {
+	Name   string          `json:"name"`
+	States []*CatalogState `json:"states"`
+}
+
+// CatalogState is a state of the catalog
+type CatalogState struct {
+	Name   string         `json:"name"`
+	Cities []*CatalogCity `json:"cities"`
+}
+
+// CatalogCity is a city of the catalog
+type CatalogCity struct {
+	Name     string            `json:"name"`
+	Stations []*CatalogStation `json:"stations,omitempty"`
+}
+
+// CatalogStation is a station of the catalog
+type CatalogStation struct {
+	Name     string    `json:"name"`
+	Location *Location `json:"location"`
+}
+
+// Sort sort countries, states, cities and stations of the catalog by name
+func (c *Catalog) Sort() {
+	sort.Slice(c.Countries, func(i, j int) bool { return c.Countries[i].Name < c.Countries[j].Name })
+	for _, country := range c.Countries {
+		sort.Slice(country.States, func(i, j int) bool { return country.States[i].Name < country.States[j].Name })
+		for _, state := range country.States {
+			sort.Slice(state.Cities, func(i, j int) bool { return state.Cities[i].Name < state.Cities[j].Name })
+			for _, city := range state.Cities {
+				sort.Slice(city.Stations, func(i, j int) bool { return city.Stations[i].Name < city.Stations[j].Name })
+			}
+		}
+	}
+}
+
+// CrawlOptions configure a catalog crawl
+type CrawlOptions struct {
+	Concurrency  int      // maximum number of concurrent requests, defaults to DefaultConcurrency
+	Checkpoint   string   // file the progress is saved to and resumed from, empty disables checkpointing
+	Countries    []string // countries to crawl, empty means every supported country
+	SkipStations bool     // do not list stations, which require a Startup or Enterprise plan
+}
+
+// crawl is the progress of a crawl, done contains keys of the nodes whose children are listed
+type crawl struct {
+	Catalog *Catalog        `json:"catalog"`
+	Done    map[string]bool `json:"done"`
+
+	mu sync.Mutex
+}
+
+func crawlKey(names ...string) string {
+	return strings.Join(names, "/")
+}
+
+func (cr *crawl) done(key string) bool {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	return cr.Done[key]
+}
+
+// complete call set and mark the node as done
+func (cr *crawl) complete(key string, set func()) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	set()
+	cr.Done[key] = true
+}
+
+func loadCrawl(path string) (*crawl, error) {
+	cr := &crawl{Catalog: &Catalog{}, Done: map[string]bool{}}
+	if path == "" {
+		return cr, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cr, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read crawl checkpoint: %w", err)
+	}
+
+	if err := json.Unmarshal(data, cr); err != nil {
+		return nil, fmt.Errorf("unable to decode crawl checkpoint: %w", err)
+	}
+
+	return cr, nil
+}
+
+func (cr *crawl) save(path string) error {
+	data, err := json.Marshal(cr)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "checkpoint-*")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+
+	return err
+}
+
+// Crawl walk Countries, States, Cities and Stations of api to build the catalog of supported locations. Requests
+// made with a Client go through its rate limiter and retry policy. When a request fails, e.g. with
+// call_limit_reached, the progress is saved to the checkpoint and the partial catalog is returned with the error,
+// crawling again with the same checkpoint resume from there. The checkpoint is removed once the crawl completes.
+func Crawl(ctx context.Context, api API, opts CrawlOptions) (*Catalog, error) {
+	cr, err := loadCrawl(opts.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	save := func() error {
+		if opts.Checkpoint == "" {
+			return nil
+		}
+		if err := cr.save(opts.Checkpoint); err != nil {
+			return fmt.Errorf("unable to save crawl checkpoint: %w", err)
+		}
+		return nil
+	}
+
+	for _, level := range []func(context.Context, API, *crawl, CrawlOptions) error{
+		crawlCountries, crawlStates, crawlCities, crawlStations,
+	} {
+		if err := level(ctx, api, cr, opts); err != nil {
+			if saveErr := save(); saveErr != nil {
+				return cr.Catalog, fmt.Errorf("%v, %w", saveErr, err)
+			}
+			return cr.Catalog, err
+		}
+		if err := save(); err != nil {
+			return cr.Catalog, err
+		}
+	}
+
+	if opts.Checkpoint != "" {
+		os.Remove(opts.Checkpoint)
+	}
+
+	return cr.Catalog, nil
+}
+
+func crawlCountries(ctx context.Context, api API, cr *crawl, opts CrawlOptions) error {
+	if cr.done(crawlKey()) {
+		return nil
+	}
+
+	names := opts.Countries
+	if len(names) == 0 {
+		countries, err := api.CountriesContext(ctx)
+		if err != nil {
+			return err
+		}
+		for _, country := range countries {
+			names = append(names, country.Country)
+		}
+	}
+
+	cr.complete(crawlKey(), func() {
+		for _, name := range names {
+			cr.Catalog.Countries = append(cr.Catalog.Countries, &CatalogCountry{Name: name, States: []*CatalogState{}})
+		}
+	})
+
+	return nil
+}
+
+func crawlStates(ctx context.Context, api API, cr *crawl, opts CrawlOptions) error {
+	var pending []*CatalogCountry
+	for _, country := range cr.Catalog.Countries {
+		if !cr.done(crawlKey(country.Name)) {
+			pending = append(pending, country)
+		}
+	}
+
+	return crawlEach(ctx, len(pending), opts.Concurrency, func(ctx context.Context, i int) error {
+		country := pending[i]
+		states, err := api.StatesContext(ctx, country.Name)
+		if err != nil {
+			return err
+		}
+
+		cr.complete(crawlKey(country.Name), func() {
+			country.States = make([]*CatalogState, len(states))
+			for i, s := range states {
+				country.States[i] = &CatalogState{Name: s.State, Cities: []*CatalogCity{}}
+			}
+		})
+		return nil
+	})
+}
+
+func crawlCities(ctx context.Context, api API, cr *crawl, opts CrawlOptions) error {
+	type node struct {
+		country string
+		state   *CatalogState
+	}
+
+	var pending []node
+	for _, country := range cr.Catalog.Countries {
+		for _, state := range country.States {
+			if !cr.done(crawlKey(country.Name, state.Name)) {
+				pending = append(pending, node{country.Name, state})
+			}
+		}
+	}
+
+	return crawlEach(ctx, len(pending), opts.Concurrency, func(ctx context.Context, i int) error {
+		n := pending[i]
+		cities, err := api.CitiesContext(ctx, n.state.Name, n.country)
+		if err != nil {
+			return err
+		}
+
+		cr.complete(crawlKey(n.country, n.state.Name), func() {
+			n.state.Cities = make([]*CatalogCity, len(cities))
+			for i, city := range cities {
+				n.state.Cities[i] = &CatalogCity{Name: city.City}
+			}
+		})
+		return nil
+	})
+}
+
+func crawlStations(ctx context.Context, api API, cr *crawl, opts CrawlOptions) error {
+	if opts.SkipStations {
+		return nil
+	}
+
+	type node struct {
+		country, state string
+		city           *CatalogCity
+	}
+
+	var pending []node
+	for _, country := range cr.Catalog.Countries {
+		for _, state := range country.States {
+			for _, city := range state.Cities {
+				if !cr.done(crawlKey(country.Name, state.Name, city.Name)) {
+					pending = append(pending, node{country.Name, state.Name, city})
+				}
+			}
+		}
+	}
+
+	return crawlEach(ctx, len(pending), opts.Concurrency, func(ctx context.Context, i int) error {
+		n := pending[i]
+		stations, err := api.StationsContext(ctx, n.city.Name, n.state, n.country)
+		if err != nil {
+			return err
+		}
+
+		cr.complete(crawlKey(n.country, n.state, n.city.Name), func() {
+			n.city.Stations = make([]*CatalogStation, len(stations))
+			for i, s := range stations {
+				n.city.Stations[i] = &CatalogStation{Name: s.Station, Location: s.Location}
+			}
+		})
+		return nil
+	})
+}
+
+// crawlEach call fn for every index below n with bounded concurrency, it stops at the first error and return it
+func crawlEach(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var first error
+	parallel(n, concurrency, func(i int) {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := fn(ctx, i); err != nil {
+			once.Do(func() {
+				first = err
+				cancel()
+			})
+		}
+	})
+	if first == nil {
+		first = ctx.Err()
+	}
+
+	return first
+}
@@ -0,0 +1,149 @@
+package airvisual
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+// mockCatalogServer serve a small catalog, answering call_limit_reached once limit successful calls are made
+func mockCatalogServer(limit *int32, calls *int32) *httptest.Server {
+	states := map[string][]string{"Andorra": {"Canillo"}, "Argentina": {"Buenos Aires", "Cordoba"}}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) > atomic.LoadInt32(limit) {
+			atomic.AddInt32(calls, -1)
+			w.Write([]byte(`{"status":"call_limit_reached","data":{"message":"call_limit_reached"}}`))
+			return
+		}
+
+		q := r.URL.Query()
+		var data interface{}
+		switch r.URL.Path {
+		case countriesEndpoint:
+			data = []*Countries{{Country: "Andorra"}, {Country: "Argentina"}}
+		case statesEndpoint:
+			var list []*States
+			for _, s := range states[q.Get("country")] {
+				list = append(list, &States{State: s})
+			}
+			data = list
+		case citiesEndpoint:
+			data = []*Cities{{City: q.Get("state") + " City"}}
+		case stationsEndpoint:
+			data = []*Stations{{Station: q.Get("city") + " Station", Location: &Location{Type: "Point", Coordinates: []float64{1, 2}}}}
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": data})
+	}))
+}
+
+func expectedCatalog() *Catalog {
+	city := func(name string) []*CatalogCity {
+		return []*CatalogCity{{
+			Name:     name,
+			Stations: []*CatalogStation{{Name: name + " Station", Location: &Location{Type: "Point", Coordinates: []float64{1, 2}}}},
+		}}
+	}
+
+	return &Catalog{Countries: []*CatalogCountry{
+		{Name: "Andorra", States: []*CatalogState{{Name: "Canillo", Cities: city("Canillo City")}}},
+		{Name: "Argentina", States: []*CatalogState{
+			{Name: "Buenos Aires", Cities: city("Buenos Aires City")},
+			{Name: "Cordoba", Cities: city("Cordoba City")},
+		}},
+	}}
+}
+
+func TestCrawl(t *testing.T) {
+	limit, calls := int32(100), int32(0)
+	server := mockCatalogServer(&limit, &calls)
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL))
+
+	catalog, err := Crawl(context.Background(), client, CrawlOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := expectedCatalog(); !reflect.DeepEqual(want, catalog) {
+		got, _ := json.Marshal(catalog)
+		t.Errorf("unexpected catalog %s", got)
+	}
+	if calls != 9 {
+		t.Errorf("expected 9 calls , got %d", calls)
+	}
+}
+
+func TestCrawlResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "airvisual")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	checkpoint := filepath.Join(dir, "crawl.json")
+
+	limit, calls := int32(5), int32(0)
+	server := mockCatalogServer(&limit, &calls)
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL))
+	opts := CrawlOptions{Concurrency: 1, Checkpoint: checkpoint}
+
+	catalog, err := Crawl(context.Background(), client, opts)
+	if !errors.Is(err, ErrCallLimitReached) {
+		t.Fatalf("expected %v , got %v", ErrCallLimitReached, err)
+	}
+	if len(catalog.Countries) != 2 || len(catalog.Countries[1].States) != 2 {
+		t.Errorf("expected partial catalog with states , got %#v", catalog)
+	}
+	if _, err := os.Stat(checkpoint); err != nil {
+		t.Fatalf("expected checkpoint , got %v", err)
+	}
+
+	atomic.StoreInt32(&limit, 100)
+	catalog, err = Crawl(context.Background(), client, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := expectedCatalog(); !reflect.DeepEqual(want, catalog) {
+		got, _ := json.Marshal(catalog)
+		t.Errorf("unexpected catalog %s", got)
+	}
+	if calls != 9 {
+		t.Errorf("expected 9 successful calls over both crawls , got %d", calls)
+	}
+	if _, err := os.Stat(checkpoint); !os.IsNotExist(err) {
+		t.Errorf("expected checkpoint to be removed , got %v", err)
+	}
+}
+
+func TestCrawlOptions(t *testing.T) {
+	limit, calls := int32(100), int32(0)
+	server := mockCatalogServer(&limit, &calls)
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL))
+
+	catalog, err := Crawl(context.Background(), client, CrawlOptions{Countries: []string{"Andorra"}, SkipStations: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, _ := json.Marshal(catalog)
+	want := `{"countries":[{"name":"Andorra","states":[{"name":"Canillo","cities":[{"name":"Canillo City"}]}]}]}`
+	if want != string(got) {
+		t.Errorf("expected %s , got %s", want, got)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls , got %d", calls)
+	}
+}
@@ -0,0 +1,31 @@
+package airvisual
+
+import (
+	"github.com/johanavril/airvisual/aqi"
+)
+
+func category(standard *aqi.Standard, index int) aqi.Category {
+	c, _ := standard.Category(index)
+
+	return c
+}
+
+// CategoryUS return the US EPA category of AQIUS, with its colour and health advice
+func (p *Pollution) CategoryUS() aqi.Category {
+	return category(aqi.USEPA, p.AQIUS)
+}
+
+// CategoryCN return the China MEP category of AQICN, with its colour and health advice
+func (p *Pollution) CategoryCN() aqi.Category {
+	return category(aqi.ChinaMEP, p.AQICN)
+}
+
+// CategoryUS return the US EPA category of AQIUS, with its colour and health advice
+func (f *Forecast) CategoryUS() aqi.Category {
+	return category(aqi.USEPA, f.AQIUS)
+}
+
+// CategoryCN return the China MEP category of AQICN, with its colour and health advice
+func (f *Forecast) CategoryCN() aqi.Category {
+	return category(aqi.ChinaMEP, f.AQICN)
+}
@@ -0,0 +1,69 @@
+package airvisual
+
+import (
+	"testing"
+)
+
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		name      string
+		pollution *Pollution
+		forecast  *Forecast
+		us        string
+		cn        string
+		color     string
+	}{
+		{
+			name:      "good",
+			pollution: &Pollution{AQIUS: 41, AQICN: 14},
+			forecast:  &Forecast{AQIUS: 41, AQICN: 14},
+			us:        "Good",
+			cn:        "Excellent",
+			color:     "#00E400",
+		},
+		{
+			name:      "moderate",
+			pollution: &Pollution{AQIUS: 70, AQICN: 30},
+			forecast:  &Forecast{AQIUS: 70, AQICN: 30},
+			us:        "Moderate",
+			cn:        "Excellent",
+			color:     "#FFFF00",
+		},
+		{
+			name:      "unhealthy",
+			pollution: &Pollution{AQIUS: 183, AQICN: 154},
+			forecast:  &Forecast{AQIUS: 183, AQICN: 154},
+			us:        "Unhealthy",
+			cn:        "Moderately Polluted",
+			color:     "#FF0000",
+		},
+		{
+			name:      "beyond index",
+			pollution: &Pollution{AQIUS: 700, AQICN: 600},
+			forecast:  &Forecast{AQIUS: 700, AQICN: 600},
+			us:        "Hazardous",
+			cn:        "Severely Polluted",
+			color:     "#7E0023",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.pollution.CategoryUS(); test.us != got.Name || test.color != got.Color {
+				t.Errorf("expected %s %s , got %s %s", test.us, test.color, got.Name, got.Color)
+			}
+			if got := test.forecast.CategoryUS(); test.us != got.Name {
+				t.Errorf("expected %s , got %s", test.us, got.Name)
+			}
+			if got := test.pollution.CategoryCN(); test.cn != got.Name {
+				t.Errorf("expected %s , got %s", test.cn, got.Name)
+			}
+			if got := test.forecast.CategoryCN(); test.cn != got.Name {
+				t.Errorf("expected %s , got %s", test.cn, got.Name)
+			}
+			if got := test.pollution.CategoryUS(); got.Implication == "" || got.GeneralCaution == "" || got.SensitiveCaution == "" {
+				t.Errorf("expected health advice , got %#v", got)
+			}
+		})
+	}
+}
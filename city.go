@@ -1,6 +1,7 @@
 package airvisual
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -13,23 +14,22 @@ type Cities struct {
 
 // Cities list supported cities in the specified state
 func (c *Client) Cities(state, country string) ([]*Cities, error) {
+	return c.CitiesContext(context.Background(), state, country)
+}
+
+// CitiesContext is like Cities but takes a context to cancel the request
+func (c *Client) CitiesContext(ctx context.Context, state, country string) ([]*Cities, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 	v.Add("country", country)
 	v.Add("state", state)
 
-	endpoint := c.endpoint(citiesEndpoint, v)
-
 	payload := struct {
-		Status string    `json:"status"`
-		Data   []*Cities `json:"data"`
+		Data []*Cities `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, citiesEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list cities: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to list cities: %v", payload.Status)
+		return nil, fmt.Errorf("unable to list cities: %w", err)
 	}
 
 	return payload.Data, nil
@@ -48,24 +48,27 @@ type City struct {
 
 // City return specified city's data object
 func (c *Client) City(city, state, country string) (*City, error) {
+	return c.CityContext(context.Background(), city, state, country)
+}
+
+// CityContext is like City but takes a context to cancel the request
+func (c *Client) CityContext(ctx context.Context, city, state, country string) (*City, error) {
+	if err := c.checkCity(city, state, country); err != nil {
+		return nil, fmt.Errorf("unable to retrieve city data: %w", err)
+	}
+
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 	v.Add("country", country)
 	v.Add("state", state)
 	v.Add("city", city)
 
-	endpoint := c.endpoint(cityEndpoint, v)
-
 	payload := struct {
-		Status string `json:"status"`
-		Data   *City  `json:"data"`
+		Data *City `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, cityEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve city data: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to retrieve city data: %v", payload.Status)
+		return nil, fmt.Errorf("unable to retrieve city data: %w", err)
 	}
 
 	return payload.Data, nil
@@ -73,21 +76,20 @@ func (c *Client) City(city, state, country string) (*City, error) {
 
 // NearestCityIP return nearest city's data using IP address geolocation
 func (c *Client) NearestCityIP() (*City, error) {
+	return c.NearestCityIPContext(context.Background())
+}
+
+// NearestCityIPContext is like NearestCityIP but takes a context to cancel the request
+func (c *Client) NearestCityIPContext(ctx context.Context) (*City, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 
-	endpoint := c.endpoint(nearestCityEndpoint, v)
-
 	payload := struct {
-		Status string `json:"status"`
-		Data   *City  `json:"data"`
+		Data *City `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, nearestCityEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve nearest city by IP address geolocation: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to retrieve nearest city by IP address geolocation: %v", payload.Status)
+		return nil, fmt.Errorf("unable to retrieve nearest city by IP address geolocation: %w", err)
 	}
 
 	return payload.Data, nil
@@ -95,53 +97,72 @@ func (c *Client) NearestCityIP() (*City, error) {
 
 // NearestCityGPS return nearest city's data using specified GPS coordinates
 func (c *Client) NearestCityGPS(lat, lon float64) (*City, error) {
+	return c.NearestCityGPSContext(context.Background(), lat, lon)
+}
+
+// NearestCityGPSContext is like NearestCityGPS but takes a context to cancel the request
+func (c *Client) NearestCityGPSContext(ctx context.Context, lat, lon float64) (*City, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 	v.Add("lat", strconv.FormatFloat(lat, 'f', -1, 64))
 	v.Add("lon", strconv.FormatFloat(lon, 'f', -1, 64))
 
-	endpoint := c.endpoint(nearestCityEndpoint, v)
-
 	payload := struct {
-		Status string `json:"status"`
-		Data   *City  `json:"data"`
+		Data *City `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, nearestCityEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve nearest city by GPS coordinates: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to retrieve nearest city by GPS coordinates: %v", payload.Status)
+		return nil, fmt.Errorf("unable to retrieve nearest city by GPS coordinates: %w", err)
 	}
 
 	return payload.Data, nil
 }
 
+// NearestCityGPSDistance is like NearestCityGPS but also return the distance in kilometers between the city and the coordinates
+func (c *Client) NearestCityGPSDistance(lat, lon float64) (*City, float64, error) {
+	return c.NearestCityGPSDistanceContext(context.Background(), lat, lon)
+}
+
+// NearestCityGPSDistanceContext is like NearestCityGPSDistance but takes a context to cancel the request
+func (c *Client) NearestCityGPSDistanceContext(ctx context.Context, lat, lon float64) (*City, float64, error) {
+	city, err := c.NearestCityGPSContext(ctx, lat, lon)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	distance, err := city.DistanceFrom(lat, lon)
+	if err != nil {
+		return city, 0, fmt.Errorf("unable to compute distance to nearest city: %w", err)
+	}
+
+	return city, distance, nil
+}
+
 // CityRanking contains ranking information of a city
 type CityRanking struct {
-	City    string   `json:"city"`
-	State   string   `json:"state"`
-	Country string   `json:"country"`
-	Ranking *Ranking `json:"ranking"`
+	City     string    `json:"city"`
+	State    string    `json:"state"`
+	Country  string    `json:"country"`
+	Ranking  *Ranking  `json:"ranking"`
+	Location *Location `json:"location,omitempty"` // not returned by the API, set by EnrichCityRanking
 }
 
 // CityRanking return sorted array of selected major cities in the world from highest to lowest AQI
 func (c *Client) CityRanking() ([]*CityRanking, error) {
+	return c.CityRankingContext(context.Background())
+}
+
+// CityRankingContext is like CityRanking but takes a context to cancel the request
+func (c *Client) CityRankingContext(ctx context.Context) ([]*CityRanking, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 
-	endpoint := c.endpoint(cityRankingEndpoint, v)
-
 	payload := struct {
-		Status string         `json:"status"`
-		Data   []*CityRanking `json:"data"`
+		Data []*CityRanking `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, cityRankingEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list city ranking: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to list city ranking: %v", payload.Status)
+		return nil, fmt.Errorf("unable to list city ranking: %w", err)
 	}
 
 	return payload.Data, nil
@@ -2,6 +2,7 @@ package airvisual
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -39,7 +40,11 @@ func TestCities(t *testing.T) {
   "data": []
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to list cities: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to list cities: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   citiesEndpoint,
+			}),
 		},
 	}
 
@@ -255,7 +260,7 @@ func TestCity(t *testing.T) {
 				},
 				Forecasts: []*Forecast{
 					{
-						TS:    "2019-08-05T03:00:00.000Z",
+						TS:    mustTimestamp("2019-08-05T03:00:00.000Z"),
 						AQIUS: 41,
 						AQICN: 14,
 						TP:    25,
@@ -267,14 +272,14 @@ func TestCity(t *testing.T) {
 						IC:    "03n",
 					},
 					{
-						TS:    "2019-08-07T00:00:00.000Z",
+						TS:    mustTimestamp("2019-08-07T00:00:00.000Z"),
 						AQIUS: 68,
 						AQICN: 29,
 					},
 				},
 				Current: &Current{
 					Weather: &Weather{
-						TS: "2019-08-01T23:00:00.000Z",
+						TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 						TP: 37,
 						PR: 1007,
 						HU: 14,
@@ -283,7 +288,7 @@ func TestCity(t *testing.T) {
 						IC: "01d",
 					},
 					Pollution: &Pollution{
-						TS:     "2019-08-04T19:00:00.000Z",
+						TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 						AQIUS:  70,
 						MAINUS: "p2",
 						AQICN:  30,
@@ -323,7 +328,7 @@ func TestCity(t *testing.T) {
 				History: &History{
 					Weather: []*Weather{
 						{
-							TS: "2019-08-01T23:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 							TP: 37,
 							PR: 1007,
 							HU: 14,
@@ -332,7 +337,7 @@ func TestCity(t *testing.T) {
 							IC: "01d",
 						},
 						{
-							TS: "2019-08-01T04:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T04:00:00.000Z"),
 							TP: 31,
 							PR: 1005,
 							HU: 26,
@@ -343,7 +348,7 @@ func TestCity(t *testing.T) {
 					},
 					Pollution: []*Pollution{
 						{
-							TS:     "2019-08-04T19:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 							AQIUS:  70,
 							MAINUS: "p2",
 							AQICN:  30,
@@ -380,7 +385,7 @@ func TestCity(t *testing.T) {
 							},
 						},
 						{
-							TS:     "2019-08-04T18:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T18:00:00.000Z"),
 							AQIUS:  57,
 							MAINUS: "p2",
 							AQICN:  28,
@@ -423,7 +428,11 @@ func TestCity(t *testing.T) {
   "data": null
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to retrieve city data: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to retrieve city data: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   cityEndpoint,
+			}),
 		},
 	}
 
@@ -639,7 +648,7 @@ func TestNearestCityIP(t *testing.T) {
 				},
 				Forecasts: []*Forecast{
 					{
-						TS:    "2019-08-05T03:00:00.000Z",
+						TS:    mustTimestamp("2019-08-05T03:00:00.000Z"),
 						AQIUS: 41,
 						AQICN: 14,
 						TP:    25,
@@ -651,14 +660,14 @@ func TestNearestCityIP(t *testing.T) {
 						IC:    "03n",
 					},
 					{
-						TS:    "2019-08-07T00:00:00.000Z",
+						TS:    mustTimestamp("2019-08-07T00:00:00.000Z"),
 						AQIUS: 68,
 						AQICN: 29,
 					},
 				},
 				Current: &Current{
 					Weather: &Weather{
-						TS: "2019-08-01T23:00:00.000Z",
+						TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 						TP: 37,
 						PR: 1007,
 						HU: 14,
@@ -667,7 +676,7 @@ func TestNearestCityIP(t *testing.T) {
 						IC: "01d",
 					},
 					Pollution: &Pollution{
-						TS:     "2019-08-04T19:00:00.000Z",
+						TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 						AQIUS:  70,
 						MAINUS: "p2",
 						AQICN:  30,
@@ -707,7 +716,7 @@ func TestNearestCityIP(t *testing.T) {
 				History: &History{
 					Weather: []*Weather{
 						{
-							TS: "2019-08-01T23:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 							TP: 37,
 							PR: 1007,
 							HU: 14,
@@ -716,7 +725,7 @@ func TestNearestCityIP(t *testing.T) {
 							IC: "01d",
 						},
 						{
-							TS: "2019-08-01T04:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T04:00:00.000Z"),
 							TP: 31,
 							PR: 1005,
 							HU: 26,
@@ -727,7 +736,7 @@ func TestNearestCityIP(t *testing.T) {
 					},
 					Pollution: []*Pollution{
 						{
-							TS:     "2019-08-04T19:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 							AQIUS:  70,
 							MAINUS: "p2",
 							AQICN:  30,
@@ -764,7 +773,7 @@ func TestNearestCityIP(t *testing.T) {
 							},
 						},
 						{
-							TS:     "2019-08-04T18:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T18:00:00.000Z"),
 							AQIUS:  57,
 							MAINUS: "p2",
 							AQICN:  28,
@@ -807,7 +816,11 @@ func TestNearestCityIP(t *testing.T) {
   "data": null
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to retrieve nearest city by IP address geolocation: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to retrieve nearest city by IP address geolocation: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   nearestCityEndpoint,
+			}),
 		},
 	}
 
@@ -1023,7 +1036,7 @@ func TestNearestCityGPS(t *testing.T) {
 				},
 				Forecasts: []*Forecast{
 					{
-						TS:    "2019-08-05T03:00:00.000Z",
+						TS:    mustTimestamp("2019-08-05T03:00:00.000Z"),
 						AQIUS: 41,
 						AQICN: 14,
 						TP:    25,
@@ -1035,14 +1048,14 @@ func TestNearestCityGPS(t *testing.T) {
 						IC:    "03n",
 					},
 					{
-						TS:    "2019-08-07T00:00:00.000Z",
+						TS:    mustTimestamp("2019-08-07T00:00:00.000Z"),
 						AQIUS: 68,
 						AQICN: 29,
 					},
 				},
 				Current: &Current{
 					Weather: &Weather{
-						TS: "2019-08-01T23:00:00.000Z",
+						TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 						TP: 37,
 						PR: 1007,
 						HU: 14,
@@ -1051,7 +1064,7 @@ func TestNearestCityGPS(t *testing.T) {
 						IC: "01d",
 					},
 					Pollution: &Pollution{
-						TS:     "2019-08-04T19:00:00.000Z",
+						TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 						AQIUS:  70,
 						MAINUS: "p2",
 						AQICN:  30,
@@ -1091,7 +1104,7 @@ func TestNearestCityGPS(t *testing.T) {
 				History: &History{
 					Weather: []*Weather{
 						{
-							TS: "2019-08-01T23:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 							TP: 37,
 							PR: 1007,
 							HU: 14,
@@ -1100,7 +1113,7 @@ func TestNearestCityGPS(t *testing.T) {
 							IC: "01d",
 						},
 						{
-							TS: "2019-08-01T04:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T04:00:00.000Z"),
 							TP: 31,
 							PR: 1005,
 							HU: 26,
@@ -1111,7 +1124,7 @@ func TestNearestCityGPS(t *testing.T) {
 					},
 					Pollution: []*Pollution{
 						{
-							TS:     "2019-08-04T19:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 							AQIUS:  70,
 							MAINUS: "p2",
 							AQICN:  30,
@@ -1148,7 +1161,7 @@ func TestNearestCityGPS(t *testing.T) {
 							},
 						},
 						{
-							TS:     "2019-08-04T18:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T18:00:00.000Z"),
 							AQIUS:  57,
 							MAINUS: "p2",
 							AQICN:  28,
@@ -1191,7 +1204,11 @@ func TestNearestCityGPS(t *testing.T) {
   "data": null
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to retrieve nearest city by GPS coordinates: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to retrieve nearest city by GPS coordinates: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   nearestCityEndpoint,
+			}),
 		},
 	}
 
@@ -1274,7 +1291,11 @@ func TestCityRanking(t *testing.T) {
   "data": []
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to list city ranking: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to list city ranking: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   cityRankingEndpoint,
+			}),
 		},
 	}
 
@@ -0,0 +1,264 @@
+// Command airvisual query AirVisual's API from the command line.
+//
+// Usage:
+//
+//	airvisual <command> [flags]
+//
+// The API key is read from the --key flag or the AIRVISUAL_API_KEY environment variable.
+// Run "airvisual help" to list the commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/johanavril/airvisual"
+)
+
+const keyEnv = "AIRVISUAL_API_KEY"
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error)
+}
+
+type options struct {
+	key      string
+	output   string
+	units    airvisual.UnitSystem
+	timeout  time.Duration
+	endpoint string
+	country  string
+	state    string
+	city     string
+	station  string
+	lat      float64
+	lon      float64
+	hasGPS   bool
+}
+
+var commands = []*command{
+	{
+		name:  "countries",
+		usage: "list supported countries",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			return client.CountriesContext(ctx)
+		},
+	},
+	{
+		name:  "states",
+		usage: "list supported states in --country",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			return client.StatesContext(ctx, opts.country)
+		},
+	},
+	{
+		name:  "cities",
+		usage: "list supported cities in --state and --country",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			return client.CitiesContext(ctx, opts.state, opts.country)
+		},
+	},
+	{
+		name:  "city",
+		usage: "show data of --city in --state and --country",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			return client.CityContext(ctx, opts.city, opts.state, opts.country)
+		},
+	},
+	{
+		name:  "nearest-city",
+		usage: "show data of the city nearest to --lat and --lon, or to the IP address of the caller, AirVisual cannot geolocate another address",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			if opts.hasGPS {
+				return client.NearestCityGPSContext(ctx, opts.lat, opts.lon)
+			}
+			return client.NearestCityIPContext(ctx)
+		},
+	},
+	{
+		name:  "stations",
+		usage: "list active stations in --city, --state and --country",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			return client.StationsContext(ctx, opts.city, opts.state, opts.country)
+		},
+	},
+	{
+		name:  "station",
+		usage: "show data of --station in --city, --state and --country",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			return client.StationContext(ctx, opts.station, opts.city, opts.state, opts.country)
+		},
+	},
+	{
+		name:  "nearest-station",
+		usage: "show data of the station nearest to --lat and --lon, or to the IP address of the caller, AirVisual cannot geolocate another address",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			if opts.hasGPS {
+				return client.NearestStationGPSContext(ctx, opts.lat, opts.lon)
+			}
+			return client.NearestStationIPContext(ctx)
+		},
+	},
+	{
+		name:  "ranking",
+		usage: "list major cities sorted from highest to lowest AQI",
+		run: func(ctx context.Context, client *airvisual.Client, opts *options) (interface{}, error) {
+			return client.CityRankingContext(ctx)
+		},
+	},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Getenv, os.Stdout, os.Stderr))
+}
+
+func run(args []string, getenv func(string) string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	var cmd *command
+	for _, c := range commands {
+		if c.name == args[0] {
+			cmd = c
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "unknown command %q\n\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	opts, err := parseFlags(cmd, args[1:], getenv, stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "airvisual %s: %v\n", cmd.name, err)
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+
+	client := newClient(opts)
+	result, err := cmd.run(ctx, client, opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "airvisual %s: %v\n", cmd.name, err)
+		return 1
+	}
+
+	err = write(stdout, opts.output, opts.units, result)
+	if err != nil {
+		fmt.Fprintf(stderr, "airvisual %s: %v\n", cmd.name, err)
+		return 1
+	}
+
+	return 0
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: airvisual <command> [flags]\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-16s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintf(w, "\nRun \"airvisual <command> -h\" to list the flags of a command.\n")
+}
+
+func parseFlags(cmd *command, args []string, getenv func(string) string, stderr io.Writer) (*options, error) {
+	opts := &options{}
+
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.key, "key", getenv(keyEnv), "AirVisual API key, defaults to $"+keyEnv)
+	fs.StringVar(&opts.output, "output", "table", "output format: table, json, csv or yaml")
+	units := fs.String("units", "metric", "unit system of table and CSV output: metric or imperial")
+	fs.DurationVar(&opts.timeout, "timeout", 30*time.Second, "request timeout")
+	fs.StringVar(&opts.endpoint, "endpoint", "", "override AirVisual API base endpoint")
+
+	switch cmd.name {
+	case "states":
+		fs.StringVar(&opts.country, "country", "", "country name")
+	case "cities":
+		fs.StringVar(&opts.country, "country", "", "country name")
+		fs.StringVar(&opts.state, "state", "", "state name")
+	case "city", "stations":
+		fs.StringVar(&opts.country, "country", "", "country name")
+		fs.StringVar(&opts.state, "state", "", "state name")
+		fs.StringVar(&opts.city, "city", "", "city name")
+	case "station":
+		fs.StringVar(&opts.country, "country", "", "country name")
+		fs.StringVar(&opts.state, "state", "", "state name")
+		fs.StringVar(&opts.city, "city", "", "city name")
+		fs.StringVar(&opts.station, "station", "", "station name")
+	case "nearest-city", "nearest-station":
+		fs.Float64Var(&opts.lat, "lat", 0, "latitude")
+		fs.Float64Var(&opts.lon, "lon", 0, "longitude")
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	if opts.key == "" {
+		return nil, fmt.Errorf("missing API key, use --key or $%s", keyEnv)
+	}
+
+	switch opts.output {
+	case "table", "json", "csv", "yaml":
+	default:
+		return nil, fmt.Errorf("unknown output format %q", opts.output)
+	}
+
+	opts.units, err = airvisual.ParseUnitSystem(*units)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, name := range []string{"country", "state", "city", "station"} {
+		if fs.Lookup(name) != nil && !set[name] {
+			missing = append(missing, "--"+name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing %s", strings.Join(missing, ", "))
+	}
+
+	if set["lat"] != set["lon"] {
+		return nil, errors.New("--lat and --lon must be used together")
+	}
+	opts.hasGPS = set["lat"]
+
+	return opts, nil
+}
+
+func newClient(opts *options) *airvisual.Client {
+	var clientOpts []airvisual.Option
+	if opts.endpoint != "" {
+		clientOpts = append(clientOpts, airvisual.WithBaseEndpoint(opts.endpoint))
+	}
+
+	return airvisual.New(opts.key, clientOpts...)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/johanavril/airvisual"
+	"github.com/johanavril/airvisual/airvisualtest"
+)
+
+func TestRun(t *testing.T) {
+	server := airvisualtest.NewServer("API Key")
+	defer server.Close()
+
+	server.AddCountries("Andorra", "Argentina")
+	server.AddStations("Beijing", "Beijing", "China", &airvisual.Stations{
+		Station:  "US Embassy in Beijing",
+		Location: &airvisual.Location{Type: "Point", Coordinates: []float64{116.466258, 39.954352}},
+	})
+	ts, err := airvisual.ParseTimestamp("2019-08-04T19:00:00.000Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.SetNearestCity(34.0669, -118.2417, &airvisual.City{
+		City:    "Los Angeles",
+		State:   "California",
+		Country: "USA",
+		Current: &airvisual.Current{
+			Pollution: &airvisual.Pollution{TS: ts, AQIUS: 70, MAINUS: "p2"},
+			Weather:   &airvisual.Weather{TS: ts, TP: 21, HU: 64, WS: 2.6},
+		},
+	})
+
+	env := func(key string) string {
+		if key == keyEnv {
+			return "API Key"
+		}
+		return ""
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			name:   "countries table",
+			args:   []string{"countries"},
+			stdout: "COUNTRY\nAndorra\nArgentina\n",
+		},
+		{
+			name:   "countries csv",
+			args:   []string{"countries", "--output", "csv"},
+			stdout: "COUNTRY\nAndorra\nArgentina\n",
+		},
+		{
+			name:   "countries json",
+			args:   []string{"countries", "--output", "json"},
+			stdout: "[\n  {\n    \"country\": \"Andorra\"\n  },\n  {\n    \"country\": \"Argentina\"\n  }\n]\n",
+		},
+		{
+			name:   "stations yaml",
+			args:   []string{"stations", "--city", "Beijing", "--state", "Beijing", "--country", "China", "--output", "yaml"},
+			stdout: "- location:\n    coordinates:\n      - 116.466258\n      - 39.954352\n    type: \"Point\"\n  station: \"US Embassy in Beijing\"\n",
+		},
+		{
+			name:   "nearest city by GPS",
+			args:   []string{"nearest-city", "--lat", "34.0669", "--lon", "-118.2417", "--output", "csv"},
+			stdout: "CITY,STATE,COUNTRY,LAT,LON,TIME,AQI US,MAIN US,AQI CN,MAIN CN,TEMP (C),HUMIDITY (%),WIND (M/S)\nLos Angeles,California,USA,,,2019-08-04T19:00:00.000Z,70,p2,0,,21,64,2.6\n",
+		},
+		{
+			name:   "nearest city imperial",
+			args:   []string{"nearest-city", "--lat", "34.0669", "--lon", "-118.2417", "--output", "csv", "--units", "imperial"},
+			stdout: "CITY,STATE,COUNTRY,LAT,LON,TIME,AQI US,MAIN US,AQI CN,MAIN CN,TEMP (F),HUMIDITY (%),WIND (MPH)\nLos Angeles,California,USA,,,2019-08-04T19:00:00.000Z,70,p2,0,,69.8,64,5.8\n",
+		},
+		{
+			name:   "missing location",
+			args:   []string{"city", "--city", "Los Angeles"},
+			code:   2,
+			stderr: "airvisual city: missing --country, --state\n",
+		},
+		{
+			name:   "lat without lon",
+			args:   []string{"nearest-station", "--lat", "1"},
+			code:   2,
+			stderr: "airvisual nearest-station: --lat and --lon must be used together\n",
+		},
+		{
+			name:   "api error",
+			args:   []string{"city", "--city", "Nowhere", "--state", "California", "--country", "USA"},
+			code:   1,
+			stderr: "airvisual city: unable to retrieve city data: /v2/city: city_not_found (HTTP 400)\n",
+		},
+		{
+			name:   "unknown output",
+			args:   []string{"countries", "--output", "xml"},
+			code:   2,
+			stderr: "airvisual countries: unknown output format \"xml\"\n",
+		},
+		{
+			name:   "unknown units",
+			args:   []string{"countries", "--units", "nautical"},
+			code:   2,
+			stderr: "airvisual countries: unknown unit system \"nautical\"\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			args := append(test.args, "--endpoint", server.URL)
+
+			code := run(args, env, stdout, stderr)
+
+			if test.code != code {
+				t.Errorf("expected exit code %d , got %d (%s)", test.code, code, stderr)
+			}
+			if test.stdout != stdout.String() {
+				t.Errorf("expected %q , got %q", test.stdout, stdout.String())
+			}
+			if test.stderr != stderr.String() {
+				t.Errorf("expected %q , got %q", test.stderr, stderr.String())
+			}
+		})
+	}
+}
+
+func TestRunIP(t *testing.T) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+	code := run([]string{"nearest-city", "--ip", "203.0.113.7", "--key", "API Key"}, func(string) string { return "" }, stdout, stderr)
+
+	if code != 2 || !strings.Contains(stderr.String(), "flag provided but not defined: -ip") {
+		t.Errorf("expected --ip to be rejected , got %d %q", code, stderr.String())
+	}
+}
+
+func TestRunMissingKey(t *testing.T) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+	code := run([]string{"countries"}, func(string) string { return "" }, stdout, stderr)
+
+	if code != 2 || !strings.Contains(stderr.String(), "missing API key") {
+		t.Errorf("expected missing API key error , got %d %q", code, stderr.String())
+	}
+}
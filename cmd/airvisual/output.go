@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/johanavril/airvisual"
+)
+
+func write(w io.Writer, format string, units airvisual.UnitSystem, result interface{}) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	case "yaml":
+		return writeYAML(w, result)
+	}
+
+	header, rows := tabulate(result, units)
+
+	if format == "csv" {
+		writer := csv.NewWriter(w)
+		writer.Write(header)
+		writer.WriteAll(rows)
+		return writer.Error()
+	}
+
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+// tabulate flatten a result into a header and rows for table and CSV output, weather values use units
+func tabulate(result interface{}, units airvisual.UnitSystem) ([]string, [][]string) {
+	switch r := result.(type) {
+	case []*airvisual.Countries:
+		rows := make([][]string, len(r))
+		for i, c := range r {
+			rows[i] = []string{c.Country}
+		}
+		return []string{"COUNTRY"}, rows
+	case []*airvisual.States:
+		rows := make([][]string, len(r))
+		for i, s := range r {
+			rows[i] = []string{s.State}
+		}
+		return []string{"STATE"}, rows
+	case []*airvisual.Cities:
+		rows := make([][]string, len(r))
+		for i, c := range r {
+			rows[i] = []string{c.City}
+		}
+		return []string{"CITY"}, rows
+	case []*airvisual.Stations:
+		rows := make([][]string, len(r))
+		for i, s := range r {
+			lat, lon := coordinates(s.Location)
+			rows[i] = []string{s.Station, lat, lon}
+		}
+		return []string{"STATION", "LAT", "LON"}, rows
+	case []*airvisual.CityRanking:
+		rows := make([][]string, len(r))
+		for i, c := range r {
+			aqius, aqicn := "", ""
+			if c.Ranking != nil {
+				aqius, aqicn = strconv.Itoa(c.Ranking.CurrentAQI), strconv.Itoa(c.Ranking.CurrentAQICN)
+			}
+			rows[i] = []string{strconv.Itoa(i + 1), c.City, c.State, c.Country, aqius, aqicn}
+		}
+		return []string{"RANK", "CITY", "STATE", "COUNTRY", "AQI US", "AQI CN"}, rows
+	case *airvisual.City:
+		header := append([]string{"CITY", "STATE", "COUNTRY"}, currentHeader(units)...)
+		row := append([]string{r.City, r.State, r.Country}, current(r.Location, r.Current, units)...)
+		return header, [][]string{row}
+	case *airvisual.Station:
+		header := append([]string{"STATION", "CITY", "STATE", "COUNTRY"}, currentHeader(units)...)
+		row := append([]string{r.Name, r.City, r.State, r.Country}, current(r.Location, r.Current, units)...)
+		return header, [][]string{row}
+	}
+
+	return nil, nil
+}
+
+func currentHeader(units airvisual.UnitSystem) []string {
+	return []string{
+		"LAT", "LON", "TIME", "AQI US", "MAIN US", "AQI CN", "MAIN CN",
+		"TEMP (" + unitLabel(units.Temperature()) + ")", "HUMIDITY (%)", "WIND (" + unitLabel(units.Speed()) + ")",
+	}
+}
+
+// unitLabel return the upper case symbol of a unit without degree sign, e.g. "F" or "M/S"
+func unitLabel(unit fmt.Stringer) string {
+	return strings.ToUpper(strings.TrimPrefix(unit.String(), "°"))
+}
+
+func current(location *airvisual.Location, c *airvisual.Current, units airvisual.UnitSystem) []string {
+	lat, lon := coordinates(location)
+	row := []string{lat, lon, "", "", "", "", "", "", "", ""}
+	if c == nil {
+		return row
+	}
+
+	if p := c.Pollution; p != nil {
+		row[2] = p.TS.String()
+		row[3], row[4] = strconv.Itoa(p.AQIUS), string(p.MAINUS)
+		row[5], row[6] = strconv.Itoa(p.AQICN), string(p.MAINCN)
+	}
+	if w := c.Weather; w != nil {
+		row[7], row[8], row[9] = formatUnit(w.Temperature(units.Temperature()), units), formatFloat(w.HU), formatUnit(w.WindSpeed(units.Speed()), units)
+	}
+
+	return row
+}
+
+func coordinates(location *airvisual.Location) (string, string) {
+	if location == nil || len(location.Coordinates) < 2 {
+		return "", ""
+	}
+
+	return formatFloat(location.Lat()), formatFloat(location.Lon())
+}
+
+// formatUnit format a weather value, converted values are rounded to one decimal
+func formatUnit(f float64, units airvisual.UnitSystem) string {
+	if units != airvisual.Metric {
+		f = math.Round(f*10) / 10
+	}
+
+	return formatFloat(f)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// writeYAML write result as YAML, using the same field names as its JSON encoding
+func writeYAML(w io.Writer, result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+	err = decoder.Decode(&v)
+	if err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	yamlValue(buf, v, 0)
+	if buf.Len() == 0 || buf.Bytes()[buf.Len()-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+
+	_, err = w.Write(buf.Bytes())
+
+	return err
+}
+
+func yamlValue(buf *bytes.Buffer, v interface{}, indent int) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			buf.WriteString("{}\n")
+			return
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteString(strings.Repeat("  ", indent))
+			}
+			buf.WriteString(key + ":")
+			yamlNested(buf, v[key], indent+1)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString("[]\n")
+			return
+		}
+
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteString(strings.Repeat("  ", indent))
+			}
+			buf.WriteString("- ")
+			yamlValue(buf, item, indent+1)
+		}
+	default:
+		buf.WriteString(yamlScalar(v) + "\n")
+	}
+}
+
+// yamlNested write the value of a mapping key, collections start on the next line
+func yamlNested(buf *bytes.Buffer, v interface{}, indent int) {
+	switch c := v.(type) {
+	case map[string]interface{}:
+		if len(c) > 0 {
+			buf.WriteString("\n" + strings.Repeat("  ", indent))
+			yamlValue(buf, v, indent)
+			return
+		}
+	case []interface{}:
+		if len(c) > 0 {
+			buf.WriteString("\n" + strings.Repeat("  ", indent))
+			yamlValue(buf, v, indent)
+			return
+		}
+	}
+
+	buf.WriteString(" ")
+	yamlValue(buf, v, indent)
+}
+
+func yamlScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		return strconv.Quote(v)
+	}
+
+	return fmt.Sprint(v)
+}
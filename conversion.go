@@ -0,0 +1,448 @@
+package airvisual
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johanavril/airvisual/aqi"
+)
+
+// ErrIncompatibleUnit is returned when converting a value between units that measure different quantities,
+// e.g. particulate matter from µg/m³ to ppb
+var ErrIncompatibleUnit = errors.New("incompatible unit")
+
+// Reference conditions used by US EPA to convert gas concentrations, in °C and hPa
+const (
+	StandardTemperature = 25.0
+	StandardPressure    = 1013.25
+)
+
+// TemperatureUnit is a unit of temperature
+type TemperatureUnit int
+
+// Temperature units, AirVisual reports Celsius
+const (
+	Celsius TemperatureUnit = iota
+	Fahrenheit
+	Kelvin
+)
+
+// String return the symbol of the unit
+func (u TemperatureUnit) String() string {
+	switch u {
+	case Fahrenheit:
+		return "°F"
+	case Kelvin:
+		return "K"
+	}
+
+	return "°C"
+}
+
+// PressureUnit is a unit of atmospheric pressure
+type PressureUnit int
+
+// Pressure units, AirVisual reports Hectopascal
+const (
+	Hectopascal PressureUnit = iota
+	InchOfMercury
+	MillimeterOfMercury
+)
+
+// String return the symbol of the unit
+func (u PressureUnit) String() string {
+	switch u {
+	case InchOfMercury:
+		return "inHg"
+	case MillimeterOfMercury:
+		return "mmHg"
+	}
+
+	return "hPa"
+}
+
+// SpeedUnit is a unit of wind speed
+type SpeedUnit int
+
+// Speed units, AirVisual reports MeterPerSecond
+const (
+	MeterPerSecond SpeedUnit = iota
+	KilometerPerHour
+	MilePerHour
+	Knot
+)
+
+// String return the symbol of the unit
+func (u SpeedUnit) String() string {
+	switch u {
+	case KilometerPerHour:
+		return "km/h"
+	case MilePerHour:
+		return "mph"
+	case Knot:
+		return "kn"
+	}
+
+	return "m/s"
+}
+
+// ConcentrationUnit is a unit of pollutant concentration
+type ConcentrationUnit int
+
+// Concentration units, AirVisual reports particulate matter in µg/m³, CO in ppm and other gases in ppb
+const (
+	MicrogramPerCubicMeter ConcentrationUnit = iota
+	PartPerBillion
+	PartPerMillion
+)
+
+// String return the symbol of the unit
+func (u ConcentrationUnit) String() string {
+	switch u {
+	case PartPerBillion:
+		return "ppb"
+	case PartPerMillion:
+		return "ppm"
+	}
+
+	return "µg/m³"
+}
+
+// NativeUnit return the unit AirVisual reports the concentration of a pollutant in
+func NativeUnit(pollutant Pollutant) (ConcentrationUnit, error) {
+	switch pollutant.Unit() {
+	case "µg/m³":
+		return MicrogramPerCubicMeter, nil
+	case "ppb":
+		return PartPerBillion, nil
+	case "ppm":
+		return PartPerMillion, nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", aqi.ErrUnknownPollutant, pollutant)
+}
+
+// molecularWeights contains molecular weight of gases in g/mol
+var molecularWeights = map[Pollutant]float64{
+	O3:  47.997,
+	NO2: 46.0055,
+	SO2: 64.066,
+	CO:  28.010,
+}
+
+// ConvertTemperature convert a temperature between units
+func ConvertTemperature(value float64, from, to TemperatureUnit) float64 {
+	switch from {
+	case Fahrenheit:
+		value = (value - 32) * 5 / 9
+	case Kelvin:
+		value -= 273.15
+	}
+
+	switch to {
+	case Fahrenheit:
+		return value*9/5 + 32
+	case Kelvin:
+		return value + 273.15
+	}
+
+	return value
+}
+
+// hectopascals contains the value of each pressure unit in hPa
+var hectopascals = map[PressureUnit]float64{
+	Hectopascal:         1,
+	InchOfMercury:       33.8638866667,
+	MillimeterOfMercury: 1.33322387415,
+}
+
+// ConvertPressure convert a pressure between units
+func ConvertPressure(value float64, from, to PressureUnit) float64 {
+	return value * hectopascals[from] / hectopascals[to]
+}
+
+// metersPerSecond contains the value of each speed unit in m/s
+var metersPerSecond = map[SpeedUnit]float64{
+	MeterPerSecond:   1,
+	KilometerPerHour: 1 / 3.6,
+	MilePerHour:      0.44704,
+	Knot:             1852.0 / 3600,
+}
+
+// ConvertSpeed convert a speed between units
+func ConvertSpeed(value float64, from, to SpeedUnit) float64 {
+	return value * metersPerSecond[from] / metersPerSecond[to]
+}
+
+// molarVolume return the volume of a mole of gas in litres, temperature in °C and pressure in hPa
+func molarVolume(temperature, pressure float64) float64 {
+	return 10 * 8.314462618 * (temperature + 273.15) / pressure
+}
+
+// ConvertConcentration convert a pollutant concentration between units, converting between mass and
+// volume concentration of a gas depend on the air temperature in °C and pressure in hPa
+func ConvertConcentration(pollutant Pollutant, value float64, from, to ConcentrationUnit, temperature, pressure float64) (float64, error) {
+	if !pollutant.Valid() {
+		return 0, fmt.Errorf("%w: %q", aqi.ErrUnknownPollutant, pollutant)
+	}
+	if from == to {
+		return value, nil
+	}
+
+	weight, gas := molecularWeights[pollutant]
+	if !gas {
+		return 0, fmt.Errorf("%w: %s can only be measured in %s", ErrIncompatibleUnit, pollutant.Formula(), MicrogramPerCubicMeter)
+	}
+
+	var ppb float64
+	switch from {
+	case PartPerBillion:
+		ppb = value
+	case PartPerMillion:
+		ppb = value * 1000
+	default:
+		ppb = value * molarVolume(temperature, pressure) / weight
+	}
+
+	switch to {
+	case PartPerBillion:
+		return ppb, nil
+	case PartPerMillion:
+		return ppb / 1000, nil
+	}
+
+	return ppb * weight / molarVolume(temperature, pressure), nil
+}
+
+var compassPoints = []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+
+// Compass return the 16-point compass direction of an angle in degrees, e.g. "NNE"
+func Compass(degrees float64) string {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+
+	return compassPoints[int(math.Round(degrees/22.5))%16]
+}
+
+// Temperature return the temperature in the given unit
+func (w *Weather) Temperature(unit TemperatureUnit) float64 {
+	return ConvertTemperature(w.TP, Celsius, unit)
+}
+
+// Pressure return the atmospheric pressure in the given unit
+func (w *Weather) Pressure(unit PressureUnit) float64 {
+	return ConvertPressure(w.PR, Hectopascal, unit)
+}
+
+// WindSpeed return the wind speed in the given unit
+func (w *Weather) WindSpeed(unit SpeedUnit) float64 {
+	return ConvertSpeed(w.WS, MeterPerSecond, unit)
+}
+
+// WindCompass return the 16-point compass direction the wind blows from
+func (w *Weather) WindCompass() string {
+	return Compass(w.WD)
+}
+
+// Temperature return the temperature in the given unit
+func (f *Forecast) Temperature(unit TemperatureUnit) float64 {
+	return ConvertTemperature(f.TP, Celsius, unit)
+}
+
+// MinTemperature return the minimum temperature in the given unit
+func (f *Forecast) MinTemperature(unit TemperatureUnit) float64 {
+	return ConvertTemperature(f.TPMin, Celsius, unit)
+}
+
+// Pressure return the atmospheric pressure in the given unit
+func (f *Forecast) Pressure(unit PressureUnit) float64 {
+	return ConvertPressure(f.PR, Hectopascal, unit)
+}
+
+// WindSpeed return the wind speed in the given unit
+func (f *Forecast) WindSpeed(unit SpeedUnit) float64 {
+	return ConvertSpeed(f.WS, MeterPerSecond, unit)
+}
+
+// WindCompass return the 16-point compass direction the wind blows from
+func (f *Forecast) WindCompass() string {
+	return Compass(f.WD)
+}
+
+// Concentration return the concentration of the pollutant the unit belongs to in the given unit,
+// gases are converted at StandardTemperature and StandardPressure
+func (u *Unit) Concentration(pollutant Pollutant, unit ConcentrationUnit) (float64, error) {
+	return u.ConcentrationAt(pollutant, unit, StandardTemperature, StandardPressure)
+}
+
+// ConcentrationAt return the concentration of the pollutant the unit belongs to in the given unit,
+// gases are converted at the given temperature in °C and pressure in hPa
+func (u *Unit) ConcentrationAt(pollutant Pollutant, unit ConcentrationUnit, temperature, pressure float64) (float64, error) {
+	native, err := NativeUnit(pollutant)
+	if err != nil {
+		return 0, err
+	}
+
+	return ConvertConcentration(pollutant, u.CONC, native, unit, temperature, pressure)
+}
+
+// Concentration return the concentration of a pollutant of the record in the given unit, gases are
+// converted at the temperature and pressure of weather, or at standard conditions if weather is nil
+func (p *Pollution) Concentration(pollutant Pollutant, unit ConcentrationUnit, weather *Weather) (float64, error) {
+	u := p.Get(pollutant)
+	if u == nil {
+		return 0, fmt.Errorf("%w: %s", aqi.ErrNoConcentration, pollutant)
+	}
+	if weather == nil || weather.PR <= 0 {
+		return u.Concentration(pollutant, unit)
+	}
+
+	return u.ConcentrationAt(pollutant, unit, weather.TP, weather.PR)
+}
+
+// UnitSystem is a system of units used to format weather values
+type UnitSystem int
+
+// Unit systems, Metric use the units AirVisual reports
+const (
+	Metric UnitSystem = iota
+	Imperial
+)
+
+// ParseUnitSystem return the unit system of a name, either "metric" or "imperial"
+func ParseUnitSystem(name string) (UnitSystem, error) {
+	switch name {
+	case "metric":
+		return Metric, nil
+	case "imperial":
+		return Imperial, nil
+	}
+
+	return 0, fmt.Errorf("unknown unit system %q", name)
+}
+
+// String return the name of the unit system
+func (s UnitSystem) String() string {
+	if s == Imperial {
+		return "imperial"
+	}
+
+	return "metric"
+}
+
+// Temperature return the temperature unit of the system
+func (s UnitSystem) Temperature() TemperatureUnit {
+	if s == Imperial {
+		return Fahrenheit
+	}
+
+	return Celsius
+}
+
+// Pressure return the pressure unit of the system
+func (s UnitSystem) Pressure() PressureUnit {
+	if s == Imperial {
+		return InchOfMercury
+	}
+
+	return Hectopascal
+}
+
+// Speed return the speed unit of the system
+func (s UnitSystem) Speed() SpeedUnit {
+	if s == Imperial {
+		return MilePerHour
+	}
+
+	return MeterPerSecond
+}
+
+// FormatTemperature format a temperature in °C using the system's unit, e.g. "69.8 °F"
+func (s UnitSystem) FormatTemperature(celsius float64) string {
+	return format(ConvertTemperature(celsius, Celsius, s.Temperature()), s.Temperature().String())
+}
+
+// FormatPressure format a pressure in hPa using the system's unit, e.g. "29.92 inHg"
+func (s UnitSystem) FormatPressure(hectopascal float64) string {
+	decimals := 0
+	if s == Imperial {
+		decimals = 2
+	}
+
+	return strconv.FormatFloat(ConvertPressure(hectopascal, Hectopascal, s.Pressure()), 'f', decimals, 64) + " " + s.Pressure().String()
+}
+
+// FormatWind format a wind speed in m/s and direction in degrees using the system's unit, e.g. "8.1 mph NW"
+func (s UnitSystem) FormatWind(speed, direction float64) string {
+	return format(ConvertSpeed(speed, MeterPerSecond, s.Speed()), s.Speed().String()) + " " + Compass(direction)
+}
+
+func format(value float64, symbol string) string {
+	return strconv.FormatFloat(value, 'f', 1, 64) + " " + symbol
+}
+
+// FormatWeather format the temperature, humidity, pressure and wind of a reading using the system's units,
+// e.g. "69.8 °F, 64 %, 29.92 inHg, 8.1 mph NW"
+func (s UnitSystem) FormatWeather(w *Weather) string {
+	if w == nil {
+		return ""
+	}
+
+	return strings.Join([]string{
+		s.FormatTemperature(w.TP), formatHumidity(w.HU), s.FormatPressure(w.PR), s.FormatWind(w.WS, w.WD),
+	}, ", ")
+}
+
+// FormatForecast format the temperature range, humidity, pressure and wind of a forecast using the system's units,
+// e.g. "59.0 °F to 69.8 °F, 64 %, 29.92 inHg, 8.1 mph NW", values missing from the forecast are left out
+func (s UnitSystem) FormatForecast(f *Forecast) string {
+	if f == nil {
+		return ""
+	}
+
+	parts := []string{s.FormatTemperature(f.TP)}
+	if f.TPMin != 0 {
+		parts[0] = s.FormatTemperature(f.TPMin) + " to " + parts[0]
+	}
+	if f.HU > 0 {
+		parts = append(parts, formatHumidity(f.HU))
+	}
+	if f.PR > 0 {
+		parts = append(parts, s.FormatPressure(f.PR))
+	}
+	parts = append(parts, s.FormatWind(f.WS, f.WD))
+
+	return strings.Join(parts, ", ")
+}
+
+func formatHumidity(humidity float64) string {
+	return strconv.FormatFloat(humidity, 'f', -1, 64) + " %"
+}
+
+// WithUnitSystem set the unit system of the client's FormatWeather and FormatForecast, defaults to Metric.
+// Responses stay in the metric units AirVisual reports
+func WithUnitSystem(system UnitSystem) Option {
+	return func(c *Client) {
+		c.units = system
+	}
+}
+
+// UnitSystem return the unit system set with WithUnitSystem
+func (c *Client) UnitSystem() UnitSystem {
+	return c.units
+}
+
+// FormatWeather format a reading using the client's unit system, see UnitSystem.FormatWeather
+func (c *Client) FormatWeather(w *Weather) string {
+	return c.units.FormatWeather(w)
+}
+
+// FormatForecast format a forecast using the client's unit system, see UnitSystem.FormatForecast
+func (c *Client) FormatForecast(f *Forecast) string {
+	return c.units.FormatForecast(f)
+}
@@ -0,0 +1,194 @@
+package airvisual
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/johanavril/airvisual/aqi"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 0.01
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "celsius to fahrenheit", got: ConvertTemperature(21, Celsius, Fahrenheit), want: 69.8},
+		{name: "kelvin to celsius", got: ConvertTemperature(300, Kelvin, Celsius), want: 26.85},
+		{name: "fahrenheit to kelvin", got: ConvertTemperature(32, Fahrenheit, Kelvin), want: 273.15},
+		{name: "hectopascal to inch of mercury", got: ConvertPressure(1013.25, Hectopascal, InchOfMercury), want: 29.92},
+		{name: "millimeter of mercury to hectopascal", got: ConvertPressure(760, MillimeterOfMercury, Hectopascal), want: 1013.25},
+		{name: "meter per second to mile per hour", got: ConvertSpeed(10, MeterPerSecond, MilePerHour), want: 22.37},
+		{name: "kilometer per hour to knot", got: ConvertSpeed(36, KilometerPerHour, Knot), want: 19.44},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !almostEqual(test.want, test.got) {
+				t.Errorf("expected %v , got %v", test.want, test.got)
+			}
+		})
+	}
+}
+
+func TestConvertConcentration(t *testing.T) {
+	tests := []struct {
+		name        string
+		pollutant   Pollutant
+		value       float64
+		from, to    ConcentrationUnit
+		temperature float64
+		pressure    float64
+		want        float64
+		err         error
+	}{
+		{name: "no2 ppb to µg/m³", pollutant: NO2, value: 100, from: PartPerBillion, to: MicrogramPerCubicMeter, temperature: 25, pressure: 1013.25, want: 188.04},
+		{name: "co ppm to µg/m³", pollutant: CO, value: 1, from: PartPerMillion, to: MicrogramPerCubicMeter, temperature: 25, pressure: 1013.25, want: 1144.88},
+		{name: "o3 µg/m³ to ppb", pollutant: O3, value: 100, from: MicrogramPerCubicMeter, to: PartPerBillion, temperature: 25, pressure: 1013.25, want: 50.97},
+		{name: "so2 ppb to µg/m³ at altitude", pollutant: SO2, value: 10, from: PartPerBillion, to: MicrogramPerCubicMeter, temperature: 0, pressure: 850, want: 23.98},
+		{name: "so2 ppb to ppm", pollutant: SO2, value: 10, from: PartPerBillion, to: PartPerMillion, want: 0.01},
+		{name: "same unit", pollutant: PM25, value: 12, from: MicrogramPerCubicMeter, to: MicrogramPerCubicMeter, want: 12},
+		{name: "particulate to ppb", pollutant: PM25, value: 12, from: MicrogramPerCubicMeter, to: PartPerBillion, err: ErrIncompatibleUnit},
+		{name: "unknown pollutant", pollutant: "xx", value: 12, from: PartPerBillion, to: PartPerMillion, err: aqi.ErrUnknownPollutant},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ConvertConcentration(test.pollutant, test.value, test.from, test.to, test.temperature, test.pressure)
+
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v , got %v", test.err, err)
+			}
+			if !almostEqual(test.want, got) {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPollutionConcentration(t *testing.T) {
+	pollution := &Pollution{P2: &Unit{CONC: 21}, N2: &Unit{CONC: 100}}
+
+	if got, err := pollution.Concentration(NO2, MicrogramPerCubicMeter, nil); err != nil || !almostEqual(188.04, got) {
+		t.Errorf("expected 188.04 , got %v %v", got, err)
+	}
+	if got, err := pollution.Concentration(NO2, MicrogramPerCubicMeter, &Weather{TP: 0, PR: 850}); err != nil || !almostEqual(172.19, got) {
+		t.Errorf("expected 172.19 , got %v %v", got, err)
+	}
+	if got, err := pollution.Concentration(PM25, MicrogramPerCubicMeter, nil); err != nil || got != 21 {
+		t.Errorf("expected 21 , got %v %v", got, err)
+	}
+	if _, err := pollution.Concentration(SO2, PartPerBillion, nil); !errors.Is(err, aqi.ErrNoConcentration) {
+		t.Errorf("expected %v , got %v", aqi.ErrNoConcentration, err)
+	}
+}
+
+func TestWeatherUnits(t *testing.T) {
+	weather := &Weather{TP: 21, PR: 1013.25, WS: 3.6, WD: 315}
+
+	if got := weather.Temperature(Fahrenheit); !almostEqual(69.8, got) {
+		t.Errorf("expected 69.8 , got %v", got)
+	}
+	if got := weather.Pressure(InchOfMercury); !almostEqual(29.92, got) {
+		t.Errorf("expected 29.92 , got %v", got)
+	}
+	if got := weather.WindSpeed(KilometerPerHour); !almostEqual(12.96, got) {
+		t.Errorf("expected 12.96 , got %v", got)
+	}
+	if got := weather.WindCompass(); got != "NW" {
+		t.Errorf("expected NW , got %s", got)
+	}
+
+	forecast := &Forecast{TP: 30, TPMin: 20, WS: 1}
+	if got := forecast.Temperature(Kelvin); !almostEqual(303.15, got) {
+		t.Errorf("expected 303.15 , got %v", got)
+	}
+	if got := forecast.MinTemperature(Fahrenheit); !almostEqual(68, got) {
+		t.Errorf("expected 68 , got %v", got)
+	}
+	if got := forecast.WindSpeed(MilePerHour); !almostEqual(2.24, got) {
+		t.Errorf("expected 2.24 , got %v", got)
+	}
+}
+
+func TestCompass(t *testing.T) {
+	tests := map[float64]string{
+		0:      "N",
+		11.2:   "N",
+		11.25:  "NNE",
+		90:     "E",
+		225:    "SW",
+		337.5:  "NNW",
+		355:    "N",
+		-90:    "W",
+		720.01: "N",
+	}
+
+	for degrees, want := range tests {
+		if got := Compass(degrees); want != got {
+			t.Errorf("expected %s for %v° , got %s", want, degrees, got)
+		}
+	}
+}
+
+func TestUnitSystem(t *testing.T) {
+	if got := New("API Key").UnitSystem(); got != Metric {
+		t.Errorf("expected %s , got %s", Metric, got)
+	}
+	if got := New("API Key", WithUnitSystem(Imperial)).UnitSystem(); got != Imperial {
+		t.Errorf("expected %s , got %s", Imperial, got)
+	}
+
+	tests := []struct {
+		system      UnitSystem
+		temperature string
+		pressure    string
+		wind        string
+	}{
+		{system: Metric, temperature: "21.0 °C", pressure: "1013 hPa", wind: "3.6 m/s NW"},
+		{system: Imperial, temperature: "69.8 °F", pressure: "29.92 inHg", wind: "8.1 mph NW"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.system.String(), func(t *testing.T) {
+			system, err := ParseUnitSystem(test.system.String())
+			if err != nil || system != test.system {
+				t.Fatalf("expected %s , got %s %v", test.system, system, err)
+			}
+			if got := system.FormatTemperature(21); test.temperature != got {
+				t.Errorf("expected %s , got %s", test.temperature, got)
+			}
+			if got := system.FormatPressure(1013.25); test.pressure != got {
+				t.Errorf("expected %s , got %s", test.pressure, got)
+			}
+			if got := system.FormatWind(3.6, 315); test.wind != got {
+				t.Errorf("expected %s , got %s", test.wind, got)
+			}
+		})
+	}
+
+	weather := &Weather{TP: 21, HU: 64, PR: 1013.25, WS: 3.6, WD: 315}
+	forecast := &Forecast{TP: 21, TPMin: 15, WS: 3.6, WD: 315}
+	metric, imperial := New("API Key"), New("API Key", WithUnitSystem(Imperial))
+	if got, want := metric.FormatWeather(weather), "21.0 °C, 64 %, 1013 hPa, 3.6 m/s NW"; want != got {
+		t.Errorf("expected %s , got %s", want, got)
+	}
+	if got, want := imperial.FormatWeather(weather), "69.8 °F, 64 %, 29.92 inHg, 8.1 mph NW"; want != got {
+		t.Errorf("expected %s , got %s", want, got)
+	}
+	if got, want := imperial.FormatForecast(forecast), "59.0 °F to 69.8 °F, 8.1 mph NW"; want != got {
+		t.Errorf("expected %s , got %s", want, got)
+	}
+	if got := metric.FormatWeather(nil); got != "" {
+		t.Errorf("expected empty string , got %s", got)
+	}
+
+	if _, err := ParseUnitSystem("nautical"); err == nil {
+		t.Errorf("expected error , got nil")
+	}
+}
@@ -1,6 +1,7 @@
 package airvisual
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -12,21 +13,20 @@ type Countries struct {
 
 // Countries list supported countries
 func (c *Client) Countries() ([]*Countries, error) {
+	return c.CountriesContext(context.Background())
+}
+
+// CountriesContext is like Countries but takes a context to cancel the request
+func (c *Client) CountriesContext(ctx context.Context) ([]*Countries, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 
-	endpoint := c.endpoint(countriesEndpoint, v)
-
 	payload := struct {
-		Status string       `json:"status"`
-		Data   []*Countries `json:"data"`
+		Data []*Countries `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, countriesEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list countries: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to list countries: %v", payload.Status)
+		return nil, fmt.Errorf("unable to list countries: %w", err)
 	}
 
 	return payload.Data, nil
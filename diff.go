@@ -0,0 +1,378 @@
+package airvisual
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ChangeKind is the kind of a catalog change
+type ChangeKind string
+
+// Kinds of catalog changes
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Renamed ChangeKind = "renamed"
+)
+
+// CatalogEntry is a city or a station of a catalog, stations have Station set
+type CatalogEntry struct {
+	Country  string    `json:"country,omitempty"`
+	State    string    `json:"state,omitempty"`
+	City     string    `json:"city,omitempty"`
+	Station  string    `json:"station,omitempty"`
+	Location *Location `json:"location,omitempty"`
+}
+
+func (e *CatalogEntry) level() string {
+	if e.Station != "" {
+		return "station"
+	}
+
+	return "city"
+}
+
+// name return the name of the entry and its parents, e.g. "Dongsi, Beijing, Beijing, China"
+func (e *CatalogEntry) name() string {
+	var names []string
+	for _, name := range []string{e.Station, e.City, e.State, e.Country} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Change is an added, removed or renamed catalog entry
+type Change struct {
+	Kind       ChangeKind    `json:"kind"`
+	From       *CatalogEntry `json:"from,omitempty"`       // removed or renamed entry
+	To         *CatalogEntry `json:"to,omitempty"`         // added entry or new name of a renamed entry
+	Distance   float64       `json:"distance,omitempty"`   // distance in kilometers between renamed stations
+	Similarity float64       `json:"similarity,omitempty"` // similarity of renamed entry names, from 0 to 1
+}
+
+// String describe the change on a line, e.g. "~ station Dongsi -> Dongsi Park, Beijing, Beijing, China"
+func (c *Change) String() string {
+	switch c.Kind {
+	case Added:
+		return "+ " + c.To.level() + " " + c.To.name()
+	case Removed:
+		return "- " + c.From.level() + " " + c.From.name()
+	}
+
+	s := fmt.Sprintf("~ %s %s -> %s (%.0f%% similar", c.To.level(), c.From.name(), c.To.name(), c.Similarity*100)
+	if c.From.Location != nil && c.To.Location != nil {
+		s += fmt.Sprintf(", %.2f km apart", c.Distance)
+	}
+
+	return s + ")"
+}
+
+// CatalogDiff contains the changes between two catalogs, encode it as JSON for a machine readable report
+type CatalogDiff struct {
+	Changes []*Change `json:"changes"`
+}
+
+// Count return the number of changes of a kind
+func (d *CatalogDiff) Count(kind ChangeKind) int {
+	n := 0
+	for _, c := range d.Changes {
+		if c.Kind == kind {
+			n++
+		}
+	}
+
+	return n
+}
+
+// String return the text report of the diff
+func (d *CatalogDiff) String() string {
+	var b strings.Builder
+	d.WriteText(&b)
+
+	return b.String()
+}
+
+// WriteText write the text report of the diff, a summary line followed by a line per change
+func (d *CatalogDiff) WriteText(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%d changes: %d added, %d removed, %d renamed\n",
+		len(d.Changes), d.Count(Added), d.Count(Removed), d.Count(Renamed))
+	for _, c := range d.Changes {
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, c)
+	}
+
+	return err
+}
+
+// DiffOptions configure how renames are detected
+type DiffOptions struct {
+	MaxDistance   float64 // stations closer than this distance in kilometers are rename candidates, defaults to 0.5
+	MinSimilarity float64 // entries with names at least this similar are rename candidates, from 0 to 1, defaults to 0.6
+}
+
+func (o DiffOptions) withDefaults() DiffOptions {
+	if o.MaxDistance <= 0 {
+		o.MaxDistance = 0.5
+	}
+	if o.MinSimilarity <= 0 {
+		o.MinSimilarity = 0.6
+	}
+
+	return o
+}
+
+// DiffCatalogs list cities and stations added, removed or renamed between two catalogs. Renames are detected
+// among entries of the same parent, by name similarity and, for stations, by location proximity. Stations of a
+// renamed city are compared with the stations of its new name.
+func DiffCatalogs(before, after *Catalog, opts DiffOptions) *CatalogDiff {
+	opts = opts.withDefaults()
+
+	oldCities, newCities := catalogEntries(before, false), catalogEntries(after, false)
+	changes := diffEntries(oldCities, newCities, opts)
+
+	cityNames := map[string]string{}
+	for _, c := range changes {
+		if c.Kind == Renamed {
+			cityNames[indexKey(c.From.Country, c.From.State, c.From.City)] = c.To.City
+		}
+	}
+
+	oldStations := catalogEntries(before, true)
+	for _, e := range oldStations {
+		if name, ok := cityNames[indexKey(e.Country, e.State, e.City)]; ok {
+			e.City = name
+		}
+	}
+	changes = append(changes, diffEntries(oldStations, catalogEntries(after, true), opts)...)
+
+	sortChanges(changes)
+
+	return &CatalogDiff{Changes: changes}
+}
+
+// DiffStations list stations added, removed or renamed between two lists returned by Stations for the same city
+func DiffStations(before, after []*Stations, opts DiffOptions) *CatalogDiff {
+	entries := func(stations []*Stations) []*CatalogEntry {
+		list := make([]*CatalogEntry, len(stations))
+		for i, s := range stations {
+			list[i] = &CatalogEntry{Station: s.Station, Location: s.Location}
+		}
+		return list
+	}
+
+	changes := diffEntries(entries(before), entries(after), opts.withDefaults())
+	sortChanges(changes)
+
+	return &CatalogDiff{Changes: changes}
+}
+
+// catalogEntries flatten the cities of a catalog, or its stations
+func catalogEntries(catalog *Catalog, stations bool) []*CatalogEntry {
+	var entries []*CatalogEntry
+	if catalog == nil {
+		return entries
+	}
+
+	for _, country := range catalog.Countries {
+		for _, state := range country.States {
+			for _, city := range state.Cities {
+				if !stations {
+					entries = append(entries, &CatalogEntry{Country: country.Name, State: state.Name, City: city.Name})
+					continue
+				}
+				for _, s := range city.Stations {
+					entries = append(entries, &CatalogEntry{
+						Country:  country.Name,
+						State:    state.Name,
+						City:     city.Name,
+						Station:  s.Name,
+						Location: s.Location,
+					})
+				}
+			}
+		}
+	}
+
+	return entries
+}
+
+func entryKey(e *CatalogEntry) string {
+	return indexKey(e.Country, e.State, e.City, e.Station)
+}
+
+func parentKey(e *CatalogEntry) string {
+	if e.Station != "" {
+		return indexKey(e.Country, e.State, e.City)
+	}
+
+	return indexKey(e.Country, e.State)
+}
+
+func leafName(e *CatalogEntry) string {
+	if e.Station != "" {
+		return e.Station
+	}
+
+	return e.City
+}
+
+// diffEntries compare entries by key, then pair removed and added entries of the same parent into renames,
+// best candidates first
+func diffEntries(before, after []*CatalogEntry, opts DiffOptions) []*Change {
+	oldKeys, newKeys := map[string]bool{}, map[string]bool{}
+	for _, e := range before {
+		oldKeys[entryKey(e)] = true
+	}
+	for _, e := range after {
+		newKeys[entryKey(e)] = true
+	}
+
+	var removed, added []*CatalogEntry
+	for _, e := range before {
+		if !newKeys[entryKey(e)] {
+			removed = append(removed, e)
+		}
+	}
+	for _, e := range after {
+		if !oldKeys[entryKey(e)] {
+			added = append(added, e)
+		}
+	}
+
+	type candidate struct {
+		from, to             int
+		similarity, distance float64
+		score                float64
+	}
+
+	var candidates []candidate
+	for i, from := range removed {
+		for j, to := range added {
+			if parentKey(from) != parentKey(to) {
+				continue
+			}
+
+			c := candidate{from: i, to: j, similarity: similarity(leafName(from), leafName(to))}
+			near := false
+			if from.Location != nil && to.Location != nil {
+				c.distance = from.Location.DistanceTo(to.Location)
+				near = c.distance <= opts.MaxDistance
+			}
+			if !near && c.similarity < opts.MinSimilarity {
+				continue
+			}
+
+			c.score = c.similarity
+			if near {
+				c.score += 1 - c.distance/opts.MaxDistance
+			}
+			candidates = append(candidates, c)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+
+	var changes []*Change
+	renamedFrom, renamedTo := map[int]bool{}, map[int]bool{}
+	for _, c := range candidates {
+		if renamedFrom[c.from] || renamedTo[c.to] {
+			continue
+		}
+		renamedFrom[c.from], renamedTo[c.to] = true, true
+		changes = append(changes, &Change{
+			Kind:       Renamed,
+			From:       removed[c.from],
+			To:         added[c.to],
+			Distance:   c.distance,
+			Similarity: c.similarity,
+		})
+	}
+
+	for i, e := range removed {
+		if !renamedFrom[i] {
+			changes = append(changes, &Change{Kind: Removed, From: e})
+		}
+	}
+	for i, e := range added {
+		if !renamedTo[i] {
+			changes = append(changes, &Change{Kind: Added, To: e})
+		}
+	}
+
+	return changes
+}
+
+// sortChanges sort changes by the name of their entry, then by kind
+func sortChanges(changes []*Change) {
+	entry := func(c *Change) *CatalogEntry {
+		if c.From != nil {
+			return c.From
+		}
+		return c.To
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := entry(changes[i]), entry(changes[j])
+		if a.level() != b.level() {
+			return a.level() == "city"
+		}
+		if ka, kb := entryKey(a), entryKey(b); ka != kb {
+			return ka < kb
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+}
+
+// similarity return the similarity of two names from 0 to 1, using the Levenshtein distance of their lower case forms
+func similarity(a, b string) float64 {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	longest := utf8.RuneCountInString(a)
+	if n := utf8.RuneCountInString(b); n > longest {
+		longest = n
+	}
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein([]rune(a), []rune(b)))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+
+	return a
+}
@@ -0,0 +1,98 @@
+package airvisual
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func point(lon, lat float64) *Location {
+	return &Location{Type: "Point", Coordinates: []float64{lon, lat}}
+}
+
+func TestDiffCatalogs(t *testing.T) {
+	before := &Catalog{Countries: []*CatalogCountry{
+		{Name: "China", States: []*CatalogState{{Name: "Beijing", Cities: []*CatalogCity{{Name: "Beijing", Stations: []*CatalogStation{
+			{Name: "US Embassy in Beijing", Location: point(116.466258, 39.954352)},
+			{Name: "Dongsi", Location: point(116.417, 39.929)},
+			{Name: "Tiantan", Location: point(116.407, 39.886)},
+		}}}}}},
+		{Name: "USA", States: []*CatalogState{{Name: "California", Cities: []*CatalogCity{
+			{Name: "Los Angeles"},
+			{Name: "San Fransisco", Stations: []*CatalogStation{{Name: "Mission", Location: point(-122.4194, 37.7749)}}},
+		}}}},
+	}}
+	after := &Catalog{Countries: []*CatalogCountry{
+		{Name: "China", States: []*CatalogState{{Name: "Beijing", Cities: []*CatalogCity{{Name: "Beijing", Stations: []*CatalogStation{
+			{Name: "US Embassy in Beijing", Location: point(116.466258, 39.954352)},
+			{Name: "Dongsi Park", Location: point(116.4171, 39.9291)},
+			{Name: "Wanliu", Location: point(116.287, 39.987)},
+		}}}}}},
+		{Name: "USA", States: []*CatalogState{{Name: "California", Cities: []*CatalogCity{
+			{Name: "Fresno"},
+			{Name: "Los Angeles"},
+			{Name: "San Francisco", Stations: []*CatalogStation{{Name: "Mission", Location: point(-122.4194, 37.7749)}}},
+		}}}},
+	}}
+
+	diff := DiffCatalogs(before, after, DiffOptions{})
+
+	want := "5 changes: 2 added, 1 removed, 2 renamed\n" +
+		"+ city Fresno, California, USA\n" +
+		"~ city San Fransisco, California, USA -> San Francisco, California, USA (92% similar)\n" +
+		"~ station Dongsi, Beijing, Beijing, China -> Dongsi Park, Beijing, Beijing, China (55% similar, 0.01 km apart)\n" +
+		"- station Tiantan, Beijing, Beijing, China\n" +
+		"+ station Wanliu, Beijing, Beijing, China\n"
+	if got := diff.String(); want != got {
+		t.Errorf("expected %s , got %s", want, got)
+	}
+
+	if got := DiffCatalogs(after, after, DiffOptions{}); len(got.Changes) != 0 {
+		t.Errorf("expected no changes , got %s", got)
+	}
+}
+
+func TestDiffStations(t *testing.T) {
+	before := []*Stations{
+		{Station: "Los Angeles - N. Main St.", Location: point(-118.2417, 34.0669)},
+		{Station: "Compton", Location: point(-118.2201, 33.9013)},
+	}
+	after := []*Stations{
+		{Station: "Los Angeles - North Main Street", Location: point(-118.2418, 34.0670)},
+		{Station: "Compton Airport", Location: point(-118.2437, 33.8900)},
+	}
+
+	diff := DiffStations(before, after, DiffOptions{MaxDistance: 0.1})
+
+	data, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"changes":[` +
+		`{"kind":"removed","from":{"station":"Compton","location":{"type":"Point","coordinates":[-118.2201,33.9013]}}},` +
+		`{"kind":"added","to":{"station":"Compton Airport","location":{"type":"Point","coordinates":[-118.2437,33.89]}}},` +
+		`{"kind":"renamed","from":{"station":"Los Angeles - N. Main St.","location":{"type":"Point","coordinates":[-118.2417,34.0669]}},` +
+		`"to":{"station":"Los Angeles - North Main Street","location":{"type":"Point","coordinates":[-118.2418,34.067]}},` +
+		`"distance":0.014439182646366547,"similarity":0.7419354838709677}]}`
+	if want != string(data) {
+		t.Errorf("expected %s , got %s", want, data)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "Beijing", b: "beijing", want: 1},
+		{a: "", b: "", want: 1},
+		{a: "abc", b: "", want: 0},
+		{a: "kitten", b: "sitting", want: 1 - 3.0/7},
+		{a: "São Paulo", b: "Sao Paulo", want: 1 - 1.0/9},
+	}
+
+	for _, test := range tests {
+		if got := similarity(test.a, test.b); !almostEqual(test.want, got) {
+			t.Errorf("expected %v for %q and %q , got %v", test.want, test.a, test.b, got)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package airvisual
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors matching the status codes returned by AirVisual's API,
+// use errors.Is to check an error returned by the client against them
+var (
+	ErrCallLimitReached   = errors.New("call_limit_reached")
+	ErrTooManyRequests    = errors.New("too_many_requests")
+	ErrAPIKeyExpired      = errors.New("api_key_expired")
+	ErrIncorrectAPIKey    = errors.New("incorrect_api_key")
+	ErrPermissionDenied   = errors.New("permission_denied")
+	ErrFeatureUnavailable = errors.New("feature_not_available")
+	ErrIPLocationFailed   = errors.New("ip_location_failed")
+	ErrNoNearestCity      = errors.New("no_nearest_city")
+	ErrNoNearestStation   = errors.New("no_nearest_station")
+	ErrCityNotFound       = errors.New("city_not_found")
+	ErrStationNotFound    = errors.New("station_not_found")
+	ErrNodeNotFound       = errors.New("node_not_found")
+)
+
+var sentinelErrors = map[string]error{
+	ErrCallLimitReached.Error():   ErrCallLimitReached,
+	ErrTooManyRequests.Error():    ErrTooManyRequests,
+	ErrAPIKeyExpired.Error():      ErrAPIKeyExpired,
+	ErrIncorrectAPIKey.Error():    ErrIncorrectAPIKey,
+	ErrPermissionDenied.Error():   ErrPermissionDenied,
+	ErrFeatureUnavailable.Error(): ErrFeatureUnavailable,
+	ErrIPLocationFailed.Error():   ErrIPLocationFailed,
+	ErrNoNearestCity.Error():      ErrNoNearestCity,
+	ErrNoNearestStation.Error():   ErrNoNearestStation,
+	ErrCityNotFound.Error():       ErrCityNotFound,
+	ErrStationNotFound.Error():    ErrStationNotFound,
+	ErrNodeNotFound.Error():       ErrNodeNotFound,
+}
+
+// APIError is returned when AirVisual's API responds with a non-success status
+type APIError struct {
+	HTTPStatus int           // HTTP status code of the response
+	Status     string        // value of the "status" field, e.g. "fail" or "call_limit_reached"
+	Message    string        // value of the "data.message" field, if any
+	Endpoint   string        // API path that was requested, e.g. "/v2/city"
+	RetryAfter time.Duration // value of the Retry-After header, if any
+}
+
+// Reason return the most specific AirVisual status code available
+func (e *APIError) Reason() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.Status != "" {
+		return e.Status
+	}
+	if e.HTTPStatus == http.StatusTooManyRequests {
+		return ErrTooManyRequests.Error()
+	}
+
+	return http.StatusText(e.HTTPStatus)
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: %s (HTTP %d)", e.Endpoint, e.Reason(), e.HTTPStatus)
+}
+
+// Is report whether the error matches one of the sentinel errors
+func (e *APIError) Is(target error) bool {
+	sentinel, ok := sentinelErrors[e.Reason()]
+	if ok && sentinel == target {
+		return true
+	}
+	sentinel, ok = sentinelErrors[e.Status]
+
+	return ok && sentinel == target
+}
@@ -0,0 +1,93 @@
+package airvisual
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		result   string
+		want     *APIError
+		sentinel error
+		message  string
+	}{
+		{
+			name:   "error status in success response",
+			status: http.StatusOK,
+			result: `{"status": "call_limit_reached", "data": []}`,
+			want: &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   cityEndpoint,
+			},
+			sentinel: ErrCallLimitReached,
+			message:  "unable to retrieve city data: /v2/city: call_limit_reached (HTTP 200)",
+		},
+		{
+			name:   "error message in failed response",
+			status: http.StatusBadRequest,
+			result: `{"status": "fail", "data": {"message": "city_not_found"}}`,
+			want: &APIError{
+				HTTPStatus: http.StatusBadRequest,
+				Status:     "fail",
+				Message:    "city_not_found",
+				Endpoint:   cityEndpoint,
+			},
+			sentinel: ErrCityNotFound,
+			message:  "unable to retrieve city data: /v2/city: city_not_found (HTTP 400)",
+		},
+		{
+			name:   "too many requests without body",
+			status: http.StatusTooManyRequests,
+			result: ``,
+			want: &APIError{
+				HTTPStatus: http.StatusTooManyRequests,
+				Endpoint:   cityEndpoint,
+			},
+			sentinel: ErrTooManyRequests,
+			message:  "unable to retrieve city data: /v2/city: too_many_requests (HTTP 429)",
+		},
+		{
+			name:   "server error with non JSON body",
+			status: http.StatusBadGateway,
+			result: `<html>Bad Gateway</html>`,
+			want: &APIError{
+				HTTPStatus: http.StatusBadGateway,
+				Endpoint:   cityEndpoint,
+			},
+			sentinel: nil,
+			message:  "unable to retrieve city data: /v2/city: Bad Gateway (HTTP 502)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, server := mockClientServerStatus(test.status, test.result)
+			defer server.Close()
+
+			_, err := client.City("Los Angeles", "California", "USA")
+
+			var got *APIError
+			if !errors.As(err, &got) {
+				t.Fatalf("expected *APIError , got %#v", err)
+			}
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("expected %#v , got %#v", test.want, got)
+			}
+			if test.sentinel != nil && !errors.Is(err, test.sentinel) {
+				t.Errorf("expected error to match %v , got %v", test.sentinel, err)
+			}
+			if errors.Is(err, ErrAPIKeyExpired) {
+				t.Errorf("expected error not to match %v , got %v", ErrAPIKeyExpired, err)
+			}
+			if test.message != err.Error() {
+				t.Errorf("expected %s , got %s", test.message, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package airvisual
+
+import (
+	"math"
+)
+
+// EarthRadius is the mean radius of the Earth in kilometers
+const EarthRadius = 6371.0088
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// Distance return the great-circle distance in kilometers between two points in degrees, using the haversine formula
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dPhi, dLambda := phi2-phi1, radians(lon2-lon1)
+
+	h := math.Pow(math.Sin(dPhi/2), 2) + math.Cos(phi1)*math.Cos(phi2)*math.Pow(math.Sin(dLambda/2), 2)
+
+	return 2 * EarthRadius * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
+// Bearing return the initial bearing in degrees from the first point to the second one,
+// as an angle of 360° (N=0, E=90, S=180, W=270)
+func Bearing(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dLambda := radians(lon2 - lon1)
+
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// DistanceTo return the great-circle distance in kilometers to another location
+func (l *Location) DistanceTo(other *Location) float64 {
+	return Distance(l.Lat(), l.Lon(), other.Lat(), other.Lon())
+}
+
+// BearingTo return the initial bearing in degrees to another location
+func (l *Location) BearingTo(other *Location) float64 {
+	return Bearing(l.Lat(), l.Lon(), other.Lat(), other.Lon())
+}
+
+// DistanceFrom return the distance in kilometers between the city and a point,
+// it return ErrInvalidLocation if the city has no valid location
+func (c *City) DistanceFrom(lat, lon float64) (float64, error) {
+	return distanceFrom(c.Location, lat, lon)
+}
+
+// DistanceFrom return the distance in kilometers between the station and a point,
+// it return ErrInvalidLocation if the station has no valid location
+func (s *Station) DistanceFrom(lat, lon float64) (float64, error) {
+	return distanceFrom(s.Location, lat, lon)
+}
+
+func distanceFrom(location *Location, lat, lon float64) (float64, error) {
+	if err := location.Validate(); err != nil {
+		return 0, err
+	}
+
+	return Distance(lat, lon, location.Lat(), location.Lon()), nil
+}
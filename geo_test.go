@@ -0,0 +1,84 @@
+package airvisual
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name                   string
+		lat1, lon1, lat2, lon2 float64
+		distance, bearing      float64
+	}{
+		{name: "los angeles to new york", lat1: 34.0522, lon1: -118.2437, lat2: 40.7128, lon2: -74.0060, distance: 3935.75, bearing: 65.92},
+		{name: "new york to los angeles", lat1: 40.7128, lon1: -74.0060, lat2: 34.0522, lon2: -118.2437, distance: 3935.75, bearing: 273.69},
+		{name: "beijing stations", lat1: 39.954352, lon1: 116.466258, lat2: 40.0078007235, lon2: 116.2148532181, distance: 22.23, bearing: 285.59},
+		{name: "same point", lat1: 34.0669, lon1: -118.2417, lat2: 34.0669, lon2: -118.2417},
+		{name: "due south", lat1: 10, lon1: 20, lat2: -10, lon2: 20, distance: 2223.9, bearing: 180},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Distance(test.lat1, test.lon1, test.lat2, test.lon2); !almostEqual(test.distance, got) {
+				t.Errorf("expected distance %v , got %v", test.distance, got)
+			}
+			if got := Bearing(test.lat1, test.lon1, test.lat2, test.lon2); !almostEqual(test.bearing, got) {
+				t.Errorf("expected bearing %v , got %v", test.bearing, got)
+			}
+		})
+	}
+}
+
+func TestLocationDistance(t *testing.T) {
+	losAngeles := &Location{Type: "Point", Coordinates: []float64{-118.2437, 34.0522}}
+	newYork := &Location{Type: "Point", Coordinates: []float64{-74.0060, 40.7128}}
+
+	if got := losAngeles.DistanceTo(newYork); !almostEqual(3935.75, got) {
+		t.Errorf("expected 3935.75 , got %v", got)
+	}
+	if got := losAngeles.BearingTo(newYork); !almostEqual(65.92, got) {
+		t.Errorf("expected 65.92 , got %v", got)
+	}
+
+	if got, err := (&City{Location: losAngeles}).DistanceFrom(40.7128, -74.0060); err != nil || !almostEqual(3935.75, got) {
+		t.Errorf("expected 3935.75 , got %v %v", got, err)
+	}
+	if _, err := (&Station{}).DistanceFrom(40.7128, -74.0060); !errors.Is(err, ErrInvalidLocation) {
+		t.Errorf("expected %v , got %v", ErrInvalidLocation, err)
+	}
+}
+
+func TestNearestGPSDistance(t *testing.T) {
+	client, server := mockClientServer(`{"status":"success","data":{"city":"Los Angeles","name":"Los Angeles - N. Main St.","location":{"type":"Point","coordinates":[-118.2417,34.0669]}}}`)
+	defer server.Close()
+
+	city, distance, err := client.NearestCityGPSDistance(34.1, -118.3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if city.City != "Los Angeles" || !almostEqual(6.51, distance) {
+		t.Errorf("expected Los Angeles at 6.51 km , got %s at %v km", city.City, distance)
+	}
+
+	station, distance, err := client.NearestStationGPSDistance(34.0669, -118.2417)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if station.Name != "Los Angeles - N. Main St." || distance != 0 {
+		t.Errorf("expected Los Angeles - N. Main St. at 0 km , got %s at %v km", station.Name, distance)
+	}
+}
+
+func TestNearestGPSDistanceNoLocation(t *testing.T) {
+	client, server := mockClientServer(`{"status":"success","data":{"city":"Los Angeles"}}`)
+	defer server.Close()
+
+	city, _, err := client.NearestCityGPSDistance(34.1, -118.3)
+	if !errors.Is(err, ErrInvalidLocation) {
+		t.Errorf("expected %v , got %v", ErrInvalidLocation, err)
+	}
+	if city == nil || city.City != "Los Angeles" {
+		t.Errorf("expected city to be returned , got %#v", city)
+	}
+}
@@ -0,0 +1,110 @@
+package airvisual
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrUnknownIcon is returned when parsing a weather icon code missing from the icon catalogue
+var ErrUnknownIcon = errors.New("unknown weather icon")
+
+// Icon is a weather icon code, e.g. "01d", the last letter tell whether it is a day or night icon
+type Icon string
+
+// Condition is a class of weather condition
+type Condition string
+
+// Weather conditions of the icon catalogue
+const (
+	ConditionClear        Condition = "clear"
+	ConditionClouds       Condition = "clouds"
+	ConditionRain         Condition = "rain"
+	ConditionThunderstorm Condition = "thunderstorm"
+	ConditionSnow         Condition = "snow"
+	ConditionMist         Condition = "mist"
+)
+
+// IconInfo describe a weather icon
+type IconInfo struct {
+	Code          Icon
+	Description   string
+	Day           bool
+	Condition     Condition
+	Precipitation bool
+	Emoji         string
+}
+
+var icons = map[Icon]IconInfo{}
+
+func init() {
+	for _, icon := range []struct {
+		code          string
+		description   string
+		condition     Condition
+		precipitation bool
+		day, night    string
+	}{
+		{code: "01", description: "clear sky", condition: ConditionClear, day: "☀️", night: "🌙"},
+		{code: "02", description: "few clouds", condition: ConditionClouds, day: "🌤️", night: "☁️"},
+		{code: "03", description: "scattered clouds", condition: ConditionClouds, day: "⛅", night: "☁️"},
+		{code: "04", description: "broken clouds", condition: ConditionClouds, day: "☁️", night: "☁️"},
+		{code: "09", description: "shower rain", condition: ConditionRain, precipitation: true, day: "🌧️", night: "🌧️"},
+		{code: "10", description: "rain", condition: ConditionRain, precipitation: true, day: "🌦️", night: "🌧️"},
+		{code: "11", description: "thunderstorm", condition: ConditionThunderstorm, precipitation: true, day: "⛈️", night: "⛈️"},
+		{code: "13", description: "snow", condition: ConditionSnow, precipitation: true, day: "❄️", night: "❄️"},
+		{code: "50", description: "mist", condition: ConditionMist, day: "🌫️", night: "🌫️"},
+	} {
+		day, night := Icon(icon.code+"d"), Icon(icon.code+"n")
+		icons[day] = IconInfo{
+			Code:          day,
+			Description:   icon.description,
+			Day:           true,
+			Condition:     icon.condition,
+			Precipitation: icon.precipitation,
+			Emoji:         icon.day,
+		}
+		icons[night] = IconInfo{
+			Code:          night,
+			Description:   icon.description,
+			Condition:     icon.condition,
+			Precipitation: icon.precipitation,
+			Emoji:         icon.night,
+		}
+	}
+}
+
+// Icons return the icon catalogue, sorted by code
+func Icons() []IconInfo {
+	catalogue := make([]IconInfo, 0, len(icons))
+	for _, info := range icons {
+		catalogue = append(catalogue, info)
+	}
+	sort.Slice(catalogue, func(i, j int) bool { return catalogue[i].Code < catalogue[j].Code })
+
+	return catalogue
+}
+
+// ParseIcon return the icon of a code, it return ErrUnknownIcon if the code is missing from the catalogue
+func ParseIcon(code string) (Icon, error) {
+	icon := Icon(code)
+	if !icon.Valid() {
+		return icon, fmt.Errorf("%w: %q", ErrUnknownIcon, code)
+	}
+
+	return icon, nil
+}
+
+// Valid report whether the icon is in the catalogue, unknown codes are kept as is when decoding a response
+func (i Icon) Valid() bool {
+	_, ok := icons[i]
+
+	return ok
+}
+
+// Info return the catalogue entry of the icon, it return false if the icon is unknown
+func (i Icon) Info() (IconInfo, bool) {
+	info, ok := icons[i]
+
+	return info, ok
+}
@@ -0,0 +1,86 @@
+package airvisual
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestIconInfo(t *testing.T) {
+	tests := []struct {
+		icon          Icon
+		valid         bool
+		description   string
+		day           bool
+		condition     Condition
+		precipitation bool
+	}{
+		{icon: "01d", valid: true, description: "clear sky", day: true, condition: ConditionClear},
+		{icon: "10n", valid: true, description: "rain", condition: ConditionRain, precipitation: true},
+		{icon: "11d", valid: true, description: "thunderstorm", day: true, condition: ConditionThunderstorm, precipitation: true},
+		{icon: "50d", valid: true, description: "mist", day: true, condition: ConditionMist},
+		{icon: "99d"},
+		{icon: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.icon), func(t *testing.T) {
+			if got := test.icon.Valid(); test.valid != got {
+				t.Errorf("expected valid %t , got %t", test.valid, got)
+			}
+
+			info, ok := test.icon.Info()
+			if test.valid != ok {
+				t.Fatalf("expected ok %t , got %t", test.valid, ok)
+			}
+			if !ok {
+				return
+			}
+			if info.Code != test.icon || info.Description != test.description || info.Day != test.day ||
+				info.Condition != test.condition || info.Precipitation != test.precipitation || info.Emoji == "" {
+				t.Errorf("unexpected info %#v", info)
+			}
+		})
+	}
+}
+
+func TestIcons(t *testing.T) {
+	icons := Icons()
+	if len(icons) != 18 {
+		t.Fatalf("expected 18 icons , got %d", len(icons))
+	}
+	for i, info := range icons {
+		if i > 0 && icons[i-1].Code >= info.Code {
+			t.Errorf("expected icons sorted by code , got %s before %s", icons[i-1].Code, info.Code)
+		}
+		if !info.Code.Valid() {
+			t.Errorf("expected %s to be valid", info.Code)
+		}
+	}
+}
+
+func TestParseIcon(t *testing.T) {
+	if icon, err := ParseIcon("04n"); err != nil || icon != "04n" {
+		t.Errorf("expected 04n , got %s %v", icon, err)
+	}
+	if _, err := ParseIcon("07d"); !errors.Is(err, ErrUnknownIcon) {
+		t.Errorf("expected %v , got %v", ErrUnknownIcon, err)
+	}
+}
+
+func TestWeatherIconDecode(t *testing.T) {
+	var weather Weather
+	if err := json.Unmarshal([]byte(`{"tp":21,"ic":"13d"}`), &weather); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info, ok := weather.IC.Info(); !ok || info.Condition != ConditionSnow {
+		t.Errorf("expected snow , got %#v", info)
+	}
+
+	if err := json.Unmarshal([]byte(`{"tp":21,"ic":"xx"}`), &weather); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if weather.IC != "xx" || weather.IC.Valid() {
+		t.Errorf("expected unknown icon xx to be kept and flagged , got %q", weather.IC)
+	}
+}
@@ -0,0 +1,89 @@
+package airvisual
+
+import (
+	"errors"
+
+	"github.com/johanavril/airvisual/aqi"
+)
+
+// ErrNoPollution is returned when a city or station has no current pollution to compute an index from
+var ErrNoPollution = errors.New("no current pollution")
+
+// Concentrations return concentration of every pollutant present in the pollution record,
+// AirVisual reports particulate matter in µg/m³, CO in ppm and other gases in ppb
+func (p *Pollution) Concentrations() []aqi.Concentration {
+	var concentrations []aqi.Concentration
+	for _, pollutant := range p.Pollutants() {
+		concentrations = append(concentrations, aqi.Concentration{Pollutant: pollutant, Value: p.Get(pollutant).CONC})
+	}
+
+	return concentrations
+}
+
+// ComputeAQIUS compute US EPA AQI from the pollutant concentrations, e.g. to audit AQIUS
+func (p *Pollution) ComputeAQIUS() (aqi.Result, error) {
+	return aqi.US(p.Concentrations()...)
+}
+
+// ComputeAQICN compute China MEP AQI from the pollutant concentrations, e.g. to audit AQICN
+func (p *Pollution) ComputeAQICN() (aqi.Result, error) {
+	return aqi.China(p.Concentrations()...)
+}
+
+// ComputeAQI compute the index of the given standard from the pollutant concentrations
+func (p *Pollution) ComputeAQI(standard *aqi.Standard) (aqi.Result, error) {
+	return standard.Compute(p.Concentrations()...)
+}
+
+// LocalAQI compute the index of the current pollution using the standard of the city's country
+func (c *City) LocalAQI() (aqi.Result, *aqi.Standard, error) {
+	return localAQI(c.Country, c.Current)
+}
+
+// LocalAQI compute the index of the current pollution using the standard of the station's country
+func (s *Station) LocalAQI() (aqi.Result, *aqi.Standard, error) {
+	return localAQI(s.Country, s.Current)
+}
+
+func localAQI(country string, current *Current) (aqi.Result, *aqi.Standard, error) {
+	standard := aqi.ForCountry(country)
+	if current == nil || current.Pollution == nil {
+		return aqi.Result{}, standard, ErrNoPollution
+	}
+
+	result, err := current.Pollution.ComputeAQI(standard)
+
+	return result, standard, err
+}
+
+// FillAQIUS set AQIUS and MAINUS of the record and of its units from the pollutant concentrations,
+// e.g. for readings of your own sensors
+func (p *Pollution) FillAQIUS() error {
+	result, err := p.ComputeAQIUS()
+	if err != nil {
+		return err
+	}
+
+	p.AQIUS, p.MAINUS = result.AQI, result.Main
+	for _, pollutant := range p.Pollutants() {
+		p.Get(pollutant).AQIUS = result.SubIndices[pollutant]
+	}
+
+	return nil
+}
+
+// FillAQICN set AQICN and MAINCN of the record and of its units from the pollutant concentrations,
+// e.g. for readings of your own sensors
+func (p *Pollution) FillAQICN() error {
+	result, err := p.ComputeAQICN()
+	if err != nil {
+		return err
+	}
+
+	p.AQICN, p.MAINCN = result.AQI, result.Main
+	for _, pollutant := range p.Pollutants() {
+		p.Get(pollutant).AQICN = result.SubIndices[pollutant]
+	}
+
+	return nil
+}
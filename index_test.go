@@ -0,0 +1,112 @@
+package airvisual
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/johanavril/airvisual/aqi"
+)
+
+func TestPollutionConcentrations(t *testing.T) {
+	pollution := &Pollution{
+		P2: &Unit{CONC: 21},
+		O3: &Unit{CONC: 48},
+		CO: &Unit{CONC: 0.2},
+	}
+
+	want := []aqi.Concentration{
+		{Pollutant: aqi.PM25, Value: 21},
+		{Pollutant: aqi.O3, Value: 48},
+		{Pollutant: aqi.CO, Value: 0.2},
+	}
+	got := pollution.Concentrations()
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %#v , got %#v", want, got)
+	}
+}
+
+func TestPollutionFill(t *testing.T) {
+	pollution := &Pollution{
+		P2: &Unit{CONC: 80},
+		P1: &Unit{CONC: 30},
+		N2: &Unit{CONC: 8},
+	}
+
+	if err := pollution.FillAQIUS(); err != nil {
+		t.Fatal(err)
+	}
+	if err := pollution.FillAQICN(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Pollution{
+		AQIUS:  168,
+		MAINUS: "p2",
+		AQICN:  107,
+		MAINCN: "p2",
+		P2:     &Unit{CONC: 80, AQIUS: 168, AQICN: 107},
+		P1:     &Unit{CONC: 30, AQIUS: 28, AQICN: 30},
+		N2:     &Unit{CONC: 8, AQIUS: 8, AQICN: 8},
+	}
+
+	if !reflect.DeepEqual(want, pollution) {
+		t.Errorf("expected %#v , got %#v", want, pollution)
+	}
+
+	empty := &Pollution{}
+	if err := empty.FillAQIUS(); err == nil {
+		t.Errorf("expected error for pollution without concentrations")
+	}
+}
+
+func TestLocalAQI(t *testing.T) {
+	tests := []struct {
+		name     string
+		city     *City
+		aqi      int
+		standard *aqi.Standard
+		err      error
+	}{
+		{
+			name: "european city",
+			city: &City{
+				Country: "Germany",
+				Current: &Current{Pollution: &Pollution{P2: &Unit{CONC: 21}, N2: &Unit{CONC: 8}}},
+			},
+			aqi:      35,
+			standard: aqi.EUCAQI,
+		},
+		{
+			name: "british city",
+			city: &City{
+				Country: "United Kingdom",
+				Current: &Current{Pollution: &Pollution{P2: &Unit{CONC: 36}}},
+			},
+			aqi:      4,
+			standard: aqi.UKDAQI,
+		},
+		{
+			name:     "city without pollution",
+			city:     &City{Country: "USA"},
+			standard: aqi.USEPA,
+			err:      ErrNoPollution,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, standard, err := test.city.LocalAQI()
+
+			if test.err != err {
+				t.Errorf("expected error %v , got %v", test.err, err)
+			}
+			if test.aqi != got.AQI {
+				t.Errorf("expected %d , got %d", test.aqi, got.AQI)
+			}
+			if test.standard != standard {
+				t.Errorf("expected %s , got %s", test.standard.Name, standard.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package airvisual
+
+import (
+	"context"
+)
+
+// API is implemented by Client, depend on it instead of *Client to replace the client with a fake in tests
+type API interface {
+	Countries() ([]*Countries, error)
+	CountriesContext(ctx context.Context) ([]*Countries, error)
+	States(country string) ([]*States, error)
+	StatesContext(ctx context.Context, country string) ([]*States, error)
+	Cities(state, country string) ([]*Cities, error)
+	CitiesContext(ctx context.Context, state, country string) ([]*Cities, error)
+	City(city, state, country string) (*City, error)
+	CityContext(ctx context.Context, city, state, country string) (*City, error)
+	CityByRef(ref CityRef) (*City, error)
+	CityByRefContext(ctx context.Context, ref CityRef) (*City, error)
+	NearestCityIP() (*City, error)
+	NearestCityIPContext(ctx context.Context) (*City, error)
+	NearestCityGPS(lat, lon float64) (*City, error)
+	NearestCityGPSContext(ctx context.Context, lat, lon float64) (*City, error)
+	NearestCityGPSDistance(lat, lon float64) (*City, float64, error)
+	NearestCityGPSDistanceContext(ctx context.Context, lat, lon float64) (*City, float64, error)
+	CityRanking() ([]*CityRanking, error)
+	CityRankingContext(ctx context.Context) ([]*CityRanking, error)
+	Stations(city, state, country string) ([]*Stations, error)
+	StationsContext(ctx context.Context, city, state, country string) ([]*Stations, error)
+	StationsByRef(ref CityRef) ([]*Stations, error)
+	StationsByRefContext(ctx context.Context, ref CityRef) ([]*Stations, error)
+	Station(station, city, state, country string) (*Station, error)
+	StationContext(ctx context.Context, station, city, state, country string) (*Station, error)
+	StationByRef(ref StationRef) (*Station, error)
+	StationByRefContext(ctx context.Context, ref StationRef) (*Station, error)
+	NearestStationIP() (*Station, error)
+	NearestStationIPContext(ctx context.Context) (*Station, error)
+	NearestStationGPS(lat, lon float64) (*Station, error)
+	NearestStationGPSContext(ctx context.Context, lat, lon float64) (*Station, error)
+	NearestStationGPSDistance(lat, lon float64) (*Station, float64, error)
+	NearestStationGPSDistanceContext(ctx context.Context, lat, lon float64) (*Station, float64, error)
+	Quota() Quota
+	UnitSystem() UnitSystem
+	FormatWeather(w *Weather) string
+	FormatForecast(f *Forecast) string
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,83 @@
+package airvisual
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownLocation is returned by City and Station when a client created WithKnownLocations is asked for a
+// location missing from its catalog, no request is made
+var ErrUnknownLocation = errors.New("unknown location")
+
+// catalogIndex contains lower case keys of every node of a catalog, and keys of the cities with listed stations
+type catalogIndex map[string]bool
+
+func indexKey(names ...string) string {
+	return strings.ToLower(strings.Join(names, "\x00"))
+}
+
+func newCatalogIndex(catalog *Catalog) catalogIndex {
+	index := catalogIndex{}
+	for _, country := range catalog.Countries {
+		index[indexKey(country.Name)] = true
+		for _, state := range country.States {
+			index[indexKey(country.Name, state.Name)] = true
+			for _, city := range state.Cities {
+				index[indexKey(country.Name, state.Name, city.Name)] = true
+				if len(city.Stations) > 0 {
+					index[indexKey(country.Name, state.Name, city.Name, "")] = true
+				}
+				for _, station := range city.Stations {
+					index[indexKey(country.Name, state.Name, city.Name, station.Name)] = true
+				}
+			}
+		}
+	}
+
+	return index
+}
+
+func (i catalogIndex) has(names ...string) bool {
+	return i[indexKey(names...)]
+}
+
+func (i catalogIndex) hasStation(country, state, city, station string) bool {
+	if !i.has(country, state, city) {
+		return false
+	}
+	if !i.has(country, state, city, "") {
+		return true
+	}
+
+	return i.has(country, state, city, station)
+}
+
+// WithKnownLocations make City and Station fail with ErrUnknownLocation without spending quota for locations
+// missing from catalog, a nil catalog disables the check. Pass a complete catalog, e.g. one saved from Crawl,
+// since any location it lacks is rejected
+func WithKnownLocations(catalog *Catalog) Option {
+	return func(c *Client) {
+		if catalog == nil {
+			c.known = nil
+			return
+		}
+		c.known = newCatalogIndex(catalog)
+	}
+}
+
+func (c *Client) checkCity(city, state, country string) error {
+	if c.known != nil && !c.known.has(country, state, city) {
+		return fmt.Errorf("%w: %s, %s, %s", ErrUnknownLocation, city, state, country)
+	}
+
+	return nil
+}
+
+func (c *Client) checkStation(station, city, state, country string) error {
+	if c.known != nil && !c.known.hasStation(country, state, city, station) {
+		return fmt.Errorf("%w: %s, %s, %s, %s", ErrUnknownLocation, station, city, state, country)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWithKnownLocations(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"status":"success","data":{"city":"Paris"}}`))
+	}))
+	defer server.Close()
+
+	catalog := &Catalog{Countries: []*CatalogCountry{{Name: "France", States: []*CatalogState{{
+		Name:   "Ile-de-France",
+		Cities: []*CatalogCity{{Name: "Paris", Stations: []*CatalogStation{{Name: "Paris Centre"}}}},
+	}}}}}
+	client := New("API Key", WithBaseEndpoint(server.URL), WithKnownLocations(catalog))
+
+	if _, err := client.City("Paris", "Ile-de-France", "France"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := client.Station("Paris Centre", "Paris", "Ile-de-France", "France"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := client.City("Lyon", "Auvergne-Rhone-Alpes", "France"); !errors.Is(err, ErrUnknownLocation) {
+		t.Errorf("expected %v , got %v", ErrUnknownLocation, err)
+	}
+	if _, err := client.StationContext(context.Background(), "Paris Nord", "Paris", "Ile-de-France", "France"); !errors.Is(err, ErrUnknownLocation) {
+		t.Errorf("expected %v , got %v", ErrUnknownLocation, err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls , got %d", calls)
+	}
+
+	unchecked := New("API Key", WithBaseEndpoint(server.URL), WithKnownLocations(nil))
+	if _, err := unchecked.City("Lyon", "Auvergne-Rhone-Alpes", "France"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls , got %d", calls)
+	}
+}
@@ -0,0 +1,161 @@
+package airvisual
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidLocation is returned when a location has missing or out of range coordinates
+var ErrInvalidLocation = errors.New("invalid location")
+
+// NewLocation return a GeoJSON point of a latitude and longitude in degrees
+func NewLocation(lat, lon float64) (*Location, error) {
+	l := &Location{Type: "Point", Coordinates: []float64{lon, lat}}
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Lat return the latitude of the location, or 0 if it has no coordinates
+func (l *Location) Lat() float64 {
+	if l == nil || len(l.Coordinates) < 2 {
+		return 0
+	}
+
+	return l.Coordinates[1]
+}
+
+// Lon return the longitude of the location, or 0 if it has no coordinates
+func (l *Location) Lon() float64 {
+	if l == nil || len(l.Coordinates) < 2 {
+		return 0
+	}
+
+	return l.Coordinates[0]
+}
+
+// Validate check that the location has a longitude between -180 and 180 and a latitude between -90 and 90
+func (l *Location) Validate() error {
+	if l == nil {
+		return fmt.Errorf("%w: missing location", ErrInvalidLocation)
+	}
+	if len(l.Coordinates) < 2 {
+		return fmt.Errorf("%w: expected [lon, lat] coordinates, got %d values", ErrInvalidLocation, len(l.Coordinates))
+	}
+	if lon := l.Coordinates[0]; lon < -180 || lon > 180 {
+		return fmt.Errorf("%w: longitude %v out of range", ErrInvalidLocation, lon)
+	}
+	if lat := l.Coordinates[1]; lat < -90 || lat > 90 {
+		return fmt.Errorf("%w: latitude %v out of range", ErrInvalidLocation, lat)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON decode a GeoJSON point and validate its coordinates
+func (l *Location) UnmarshalJSON(data []byte) error {
+	type location Location
+	if err := json.Unmarshal(data, (*location)(l)); err != nil {
+		return err
+	}
+
+	return l.Validate()
+}
+
+// FeatureCollection is a GeoJSON feature collection
+type FeatureCollection struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+// Feature is a GeoJSON feature, features without location have a null geometry
+type Feature struct {
+	Type       string                 `json:"type"`
+	Geometry   *Location              `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// NewFeatureCollection convert []*Stations, *City, *Station or []*CityRanking into a GeoJSON feature
+// collection, current AQI and pollutant concentrations are added to the feature properties
+func NewFeatureCollection(v interface{}) (*FeatureCollection, error) {
+	collection := &FeatureCollection{Type: "FeatureCollection", Features: []*Feature{}}
+
+	switch v := v.(type) {
+	case []*Stations:
+		for _, s := range v {
+			collection.Features = append(collection.Features, newFeature(s.Location, map[string]interface{}{
+				"station": s.Station,
+			}))
+		}
+	case *City:
+		properties := map[string]interface{}{"city": v.City, "state": v.State, "country": v.Country}
+		addCurrent(properties, v.Current)
+		collection.Features = append(collection.Features, newFeature(v.Location, properties))
+	case *Station:
+		properties := map[string]interface{}{"station": v.Name, "city": v.City, "state": v.State, "country": v.Country}
+		addCurrent(properties, v.Current)
+		collection.Features = append(collection.Features, newFeature(v.Location, properties))
+	case []*CityRanking:
+		for i, c := range v {
+			properties := map[string]interface{}{"rank": i + 1, "city": c.City, "state": c.State, "country": c.Country}
+			if c.Ranking != nil {
+				properties["aqius"], properties["aqicn"] = c.Ranking.CurrentAQI, c.Ranking.CurrentAQICN
+			}
+			collection.Features = append(collection.Features, newFeature(c.Location, properties))
+		}
+	default:
+		return nil, fmt.Errorf("unable to convert %T to GeoJSON", v)
+	}
+
+	return collection, nil
+}
+
+// MarshalGeoJSON encode []*Stations, *City, *Station or []*CityRanking as a GeoJSON feature collection
+func MarshalGeoJSON(v interface{}) ([]byte, error) {
+	collection, err := NewFeatureCollection(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(collection)
+}
+
+func newFeature(location *Location, properties map[string]interface{}) *Feature {
+	return &Feature{Type: "Feature", Geometry: location, Properties: properties}
+}
+
+// addCurrent add the current pollution of a city or station to feature properties
+func addCurrent(properties map[string]interface{}, current *Current) {
+	if current == nil || current.Pollution == nil {
+		return
+	}
+
+	p := current.Pollution
+	properties["ts"] = p.TS
+	properties["aqius"], properties["mainus"] = p.AQIUS, p.MAINUS
+	properties["aqicn"], properties["maincn"] = p.AQICN, p.MAINCN
+	for _, pollutant := range p.Pollutants() {
+		properties[string(pollutant)] = p.Get(pollutant).CONC
+	}
+}
+
+// EnrichCityRanking set the location of ranked cities missing one, fetching each city from api
+func EnrichCityRanking(ctx context.Context, api API, ranking []*CityRanking) error {
+	for _, c := range ranking {
+		if c.Location != nil {
+			continue
+		}
+
+		city, err := api.CityContext(ctx, c.City, c.State, c.Country)
+		if err != nil {
+			return fmt.Errorf("unable to enrich %s, %s, %s: %w", c.City, c.State, c.Country, err)
+		}
+		c.Location = city.Location
+	}
+
+	return nil
+}
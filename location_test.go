@@ -0,0 +1,137 @@
+package airvisual
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewLocation(t *testing.T) {
+	location, err := NewLocation(34.0669, -118.2417)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &Location{Type: "Point", Coordinates: []float64{-118.2417, 34.0669}}
+	if !reflect.DeepEqual(want, location) {
+		t.Errorf("expected %#v , got %#v", want, location)
+	}
+	if location.Lat() != 34.0669 || location.Lon() != -118.2417 {
+		t.Errorf("expected 34.0669 -118.2417 , got %v %v", location.Lat(), location.Lon())
+	}
+
+	if _, err := NewLocation(-118.2417, 34.0669); !errors.Is(err, ErrInvalidLocation) {
+		t.Errorf("expected %v , got %v", ErrInvalidLocation, err)
+	}
+
+	var nilLocation *Location
+	if nilLocation.Lat() != 0 || nilLocation.Lon() != 0 {
+		t.Errorf("expected 0 0 , got %v %v", nilLocation.Lat(), nilLocation.Lon())
+	}
+}
+
+func TestLocationUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{name: "valid", data: `{"type":"Point","coordinates":[116.466258,39.954352]}`},
+		{name: "missing latitude", data: `{"type":"Point","coordinates":[116.466258]}`, err: ErrInvalidLocation},
+		{name: "latitude out of range", data: `{"type":"Point","coordinates":[39.954352,116.466258]}`, err: ErrInvalidLocation},
+		{name: "longitude out of range", data: `{"type":"Point","coordinates":[216.466258,39.954352]}`, err: ErrInvalidLocation},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var location Location
+			err := json.Unmarshal([]byte(test.data), &location)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected %v , got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestMarshalGeoJSON(t *testing.T) {
+	location := &Location{Type: "Point", Coordinates: []float64{-118.2417, 34.0669}}
+	ts := mustTimestamp("2019-08-04T19:00:00.000Z")
+
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{
+			name: "stations",
+			v:    []*Stations{{Station: "US Embassy in Beijing", Location: &Location{Type: "Point", Coordinates: []float64{116.466258, 39.954352}}}},
+			want: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[116.466258,39.954352]},"properties":{"station":"US Embassy in Beijing"}}]}`,
+		},
+		{
+			name: "city",
+			v: &City{
+				City:     "Los Angeles",
+				State:    "California",
+				Country:  "USA",
+				Location: location,
+				Current:  &Current{Pollution: &Pollution{TS: ts, AQIUS: 70, MAINUS: PM25, AQICN: 30, MAINCN: PM10, P2: &Unit{CONC: 21}}},
+			},
+			want: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[-118.2417,34.0669]},"properties":{"aqicn":30,"aqius":70,"city":"Los Angeles","country":"USA","maincn":"p1","mainus":"p2","p2":21,"state":"California","ts":"2019-08-04T19:00:00.000Z"}}]}`,
+		},
+		{
+			name: "station",
+			v:    &Station{Name: "US Embassy in Beijing", City: "Beijing", State: "Beijing", Country: "China"},
+			want: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":null,"properties":{"city":"Beijing","country":"China","state":"Beijing","station":"US Embassy in Beijing"}}]}`,
+		},
+		{
+			name: "ranking",
+			v:    []*CityRanking{{City: "Los Angeles", State: "California", Country: "USA", Ranking: &Ranking{CurrentAQI: 70, CurrentAQICN: 30}, Location: location}},
+			want: `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[-118.2417,34.0669]},"properties":{"aqicn":30,"aqius":70,"city":"Los Angeles","country":"USA","rank":1,"state":"California"}}]}`,
+		},
+		{
+			name: "empty",
+			v:    []*Stations{},
+			want: `{"type":"FeatureCollection","features":[]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := MarshalGeoJSON(test.v)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.want != string(got) {
+				t.Errorf("expected %s , got %s", test.want, got)
+			}
+		})
+	}
+
+	if _, err := MarshalGeoJSON(&Countries{}); err == nil {
+		t.Errorf("expected error , got nil")
+	}
+}
+
+func TestEnrichCityRanking(t *testing.T) {
+	client, server := mockClientServer(`{"status":"success","data":{"city":"Los Angeles","state":"California","country":"USA","location":{"type":"Point","coordinates":[-118.2417,34.0669]}}}`)
+	defer server.Close()
+
+	known := &Location{Type: "Point", Coordinates: []float64{2.3522, 48.8566}}
+	ranking := []*CityRanking{
+		{City: "Los Angeles", State: "California", Country: "USA"},
+		{City: "Paris", State: "Ile-de-France", Country: "France", Location: known},
+	}
+
+	if err := EnrichCityRanking(context.Background(), client, ranking); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ranking[0].Location.Lat() != 34.0669 || ranking[0].Location.Lon() != -118.2417 {
+		t.Errorf("expected 34.0669 -118.2417 , got %v", ranking[0].Location)
+	}
+	if ranking[1].Location != known {
+		t.Errorf("expected known location to be kept , got %v", ranking[1].Location)
+	}
+}
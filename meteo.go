@@ -0,0 +1,216 @@
+package airvisual
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrOutOfRange is returned when weather values are outside the validity range of a formula
+var ErrOutOfRange = errors.New("outside the validity range of the formula")
+
+// DewPoint return the dew point in °C of a temperature in °C and relative humidity in %, using the
+// Magnus formula, valid for temperatures between -45 °C and 60 °C and humidity between 0 % and 100 %
+func DewPoint(temperature, humidity float64) (float64, error) {
+	if temperature < -45 || temperature > 60 {
+		return 0, fmt.Errorf("%w: dew point requires a temperature between -45 °C and 60 °C", ErrOutOfRange)
+	}
+	if humidity <= 0 || humidity > 100 {
+		return 0, fmt.Errorf("%w: dew point requires a humidity above 0 %% and up to 100 %%", ErrOutOfRange)
+	}
+
+	const a, b = 17.62, 243.12
+	gamma := math.Log(humidity/100) + a*temperature/(b+temperature)
+
+	return b * gamma / (a - gamma), nil
+}
+
+// Comfort is the perceived humidity of the air, derived from the dew point
+type Comfort string
+
+// Comfort levels of the NWS dew point scale
+const (
+	Comfortable Comfort = "comfortable" // dew point up to 12.8 °C (55 °F)
+	Sticky      Comfort = "sticky"      // dew point up to 18.3 °C (65 °F)
+	Oppressive  Comfort = "oppressive"  // dew point above 18.3 °C
+)
+
+// ComfortLevel return the comfort level of a temperature in °C and relative humidity in %,
+// it has the validity range of DewPoint
+func ComfortLevel(temperature, humidity float64) (Comfort, error) {
+	dewPoint, err := DewPoint(temperature, humidity)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case dewPoint <= 12.8:
+		return Comfortable, nil
+	case dewPoint <= 18.3:
+		return Sticky, nil
+	}
+
+	return Oppressive, nil
+}
+
+// HeatIndex return the NWS heat index in °C of a temperature in °C and relative humidity in %,
+// valid for temperatures of at least 26.7 °C (80 °F)
+func HeatIndex(temperature, humidity float64) (float64, error) {
+	if temperature < 26.7 {
+		return 0, fmt.Errorf("%w: heat index requires a temperature of at least 26.7 °C", ErrOutOfRange)
+	}
+	if humidity < 0 || humidity > 100 {
+		return 0, fmt.Errorf("%w: heat index requires a humidity between 0 %% and 100 %%", ErrOutOfRange)
+	}
+
+	t, rh := ConvertTemperature(temperature, Celsius, Fahrenheit), humidity
+	hi := 0.5 * (t + 61 + (t-68)*1.2 + rh*0.094)
+	if (hi+t)/2 >= 80 {
+		hi = -42.379 + 2.04901523*t + 10.14333127*rh - 0.22475541*t*rh - 0.00683783*t*t - 0.05481717*rh*rh +
+			0.00122874*t*t*rh + 0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+		switch {
+		case rh < 13 && t >= 80 && t <= 112:
+			hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(t-95))/17)
+		case rh > 85 && t >= 80 && t <= 87:
+			hi += (rh - 85) / 10 * (87 - t) / 5
+		}
+	}
+
+	return ConvertTemperature(hi, Fahrenheit, Celsius), nil
+}
+
+// WindChill return the NWS wind chill in °C of a temperature in °C and wind speed in m/s,
+// valid for temperatures up to 10 °C and wind speeds above 1.34 m/s (4.8 km/h)
+func WindChill(temperature, windSpeed float64) (float64, error) {
+	if temperature > 10 {
+		return 0, fmt.Errorf("%w: wind chill requires a temperature up to 10 °C", ErrOutOfRange)
+	}
+	if windSpeed <= 1.34 {
+		return 0, fmt.Errorf("%w: wind chill requires a wind speed above 1.34 m/s", ErrOutOfRange)
+	}
+
+	v := math.Pow(ConvertSpeed(windSpeed, MeterPerSecond, KilometerPerHour), 0.16)
+
+	return 13.12 + 0.6215*temperature - 11.37*v + 0.3965*temperature*v, nil
+}
+
+// ApparentTemperature return the Steadman apparent temperature in °C used by the Australian Bureau of
+// Meteorology, from a temperature in °C, relative humidity in % and wind speed in m/s, without solar radiation
+func ApparentTemperature(temperature, humidity, windSpeed float64) float64 {
+	vapourPressure := humidity / 100 * 6.105 * math.Exp(17.27*temperature/(237.7+temperature))
+
+	return temperature + 0.33*vapourPressure - 0.70*windSpeed - 4.00
+}
+
+// FeelsLike return the temperature in °C as felt by people, the heat index or wind chill when they are valid,
+// the air temperature otherwise
+func FeelsLike(temperature, humidity, windSpeed float64) float64 {
+	if hi, err := HeatIndex(temperature, humidity); err == nil {
+		return hi
+	}
+	if wc, err := WindChill(temperature, windSpeed); err == nil {
+		return wc
+	}
+
+	return temperature
+}
+
+// Beaufort is a force of the Beaufort wind scale, from 0 to 12
+type Beaufort int
+
+var beaufortNames = []string{
+	"Calm", "Light air", "Light breeze", "Gentle breeze", "Moderate breeze", "Fresh breeze", "Strong breeze",
+	"Near gale", "Gale", "Strong gale", "Storm", "Violent storm", "Hurricane force",
+}
+
+// beaufortLimits contains the lowest wind speed in m/s of every force above 0
+var beaufortLimits = []float64{0.5, 1.6, 3.4, 5.5, 8.0, 10.8, 13.9, 17.2, 20.8, 24.5, 28.5, 32.7}
+
+// BeaufortForce return the Beaufort force of a wind speed in m/s
+func BeaufortForce(windSpeed float64) Beaufort {
+	force := 0
+	for force < len(beaufortLimits) && windSpeed >= beaufortLimits[force] {
+		force++
+	}
+
+	return Beaufort(force)
+}
+
+// String return the description of the force, e.g. "Gentle breeze"
+func (b Beaufort) String() string {
+	if b < 0 || int(b) >= len(beaufortNames) {
+		return fmt.Sprintf("Beaufort(%d)", int(b))
+	}
+
+	return beaufortNames[b]
+}
+
+// DewPoint return the dew point in °C, see DewPoint for its validity range
+func (w *Weather) DewPoint() (float64, error) {
+	return DewPoint(w.TP, w.HU)
+}
+
+// Comfort return the comfort level, see ComfortLevel for its validity range
+func (w *Weather) Comfort() (Comfort, error) {
+	return ComfortLevel(w.TP, w.HU)
+}
+
+// HeatIndex return the heat index in °C, see HeatIndex for its validity range
+func (w *Weather) HeatIndex() (float64, error) {
+	return HeatIndex(w.TP, w.HU)
+}
+
+// WindChill return the wind chill in °C, see WindChill for its validity range
+func (w *Weather) WindChill() (float64, error) {
+	return WindChill(w.TP, w.WS)
+}
+
+// ApparentTemperature return the apparent temperature in °C
+func (w *Weather) ApparentTemperature() float64 {
+	return ApparentTemperature(w.TP, w.HU, w.WS)
+}
+
+// FeelsLike return the temperature in °C as felt by people
+func (w *Weather) FeelsLike() float64 {
+	return FeelsLike(w.TP, w.HU, w.WS)
+}
+
+// Beaufort return the Beaufort force of the wind
+func (w *Weather) Beaufort() Beaufort {
+	return BeaufortForce(w.WS)
+}
+
+// DewPoint return the dew point in °C, see DewPoint for its validity range
+func (f *Forecast) DewPoint() (float64, error) {
+	return DewPoint(f.TP, f.HU)
+}
+
+// Comfort return the comfort level, see ComfortLevel for its validity range
+func (f *Forecast) Comfort() (Comfort, error) {
+	return ComfortLevel(f.TP, f.HU)
+}
+
+// HeatIndex return the heat index in °C, see HeatIndex for its validity range
+func (f *Forecast) HeatIndex() (float64, error) {
+	return HeatIndex(f.TP, f.HU)
+}
+
+// WindChill return the wind chill in °C, see WindChill for its validity range
+func (f *Forecast) WindChill() (float64, error) {
+	return WindChill(f.TP, f.WS)
+}
+
+// ApparentTemperature return the apparent temperature in °C
+func (f *Forecast) ApparentTemperature() float64 {
+	return ApparentTemperature(f.TP, f.HU, f.WS)
+}
+
+// FeelsLike return the temperature in °C as felt by people
+func (f *Forecast) FeelsLike() float64 {
+	return FeelsLike(f.TP, f.HU, f.WS)
+}
+
+// Beaufort return the Beaufort force of the wind
+func (f *Forecast) Beaufort() Beaufort {
+	return BeaufortForce(f.WS)
+}
@@ -0,0 +1,115 @@
+package airvisual
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMeteo(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func() (float64, error)
+		want float64
+		err  error
+	}{
+		{name: "dew point", fn: func() (float64, error) { return DewPoint(21, 64) }, want: 13.93},
+		{name: "dew point humid", fn: func() (float64, error) { return DewPoint(30, 80) }, want: 26.17},
+		{name: "dew point too hot", fn: func() (float64, error) { return DewPoint(65, 50) }, err: ErrOutOfRange},
+		{name: "dew point dry", fn: func() (float64, error) { return DewPoint(20, 0) }, err: ErrOutOfRange},
+		{name: "heat index", fn: func() (float64, error) { return HeatIndex(32, 70) }, want: 40.41},
+		{name: "heat index humid adjustment", fn: func() (float64, error) { return HeatIndex(27, 90) }, want: 31.09},
+		{name: "heat index dry adjustment", fn: func() (float64, error) { return HeatIndex(40, 10) }, want: 36.71},
+		{name: "heat index too cold", fn: func() (float64, error) { return HeatIndex(20, 50) }, err: ErrOutOfRange},
+		{name: "wind chill", fn: func() (float64, error) { return WindChill(-5, 5) }, want: -11.19},
+		{name: "wind chill freezing", fn: func() (float64, error) { return WindChill(0, 10) }, want: -7.05},
+		{name: "wind chill too warm", fn: func() (float64, error) { return WindChill(15, 5) }, err: ErrOutOfRange},
+		{name: "wind chill calm", fn: func() (float64, error) { return WindChill(-5, 1) }, err: ErrOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.fn()
+
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v , got %v", test.err, err)
+			}
+			if !almostEqual(test.want, got) {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestFeelsLike(t *testing.T) {
+	tests := []struct {
+		name    string
+		weather *Weather
+		want    float64
+	}{
+		{name: "heat index", weather: &Weather{TP: 32, HU: 70, WS: 1}, want: 40.41},
+		{name: "wind chill", weather: &Weather{TP: -5, HU: 70, WS: 5}, want: -11.19},
+		{name: "air temperature", weather: &Weather{TP: 18, HU: 70, WS: 5}, want: 18},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.weather.FeelsLike(); !almostEqual(test.want, got) {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+
+	if got := (&Weather{TP: 21, HU: 64, WS: 2.6}).ApparentTemperature(); !almostEqual(20.42, got) {
+		t.Errorf("expected 20.42 , got %v", got)
+	}
+	if got := (&Forecast{TP: 32, HU: 70, WS: 1}).ApparentTemperature(); !almostEqual(38.24, got) {
+		t.Errorf("expected 38.24 , got %v", got)
+	}
+}
+
+func TestComfort(t *testing.T) {
+	tests := []struct {
+		forecast *Forecast
+		want     Comfort
+	}{
+		{forecast: &Forecast{TP: 21, HU: 40}, want: Comfortable},
+		{forecast: &Forecast{TP: 21, HU: 64}, want: Sticky},
+		{forecast: &Forecast{TP: 30, HU: 80}, want: Oppressive},
+	}
+
+	for _, test := range tests {
+		if got, err := test.forecast.Comfort(); err != nil || test.want != got {
+			t.Errorf("expected %s , got %s %v", test.want, got, err)
+		}
+	}
+
+	if _, err := (&Weather{TP: 21}).Comfort(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected %v , got %v", ErrOutOfRange, err)
+	}
+}
+
+func TestBeaufort(t *testing.T) {
+	tests := []struct {
+		speed float64
+		force Beaufort
+		name  string
+	}{
+		{speed: 0, force: 0, name: "Calm"},
+		{speed: 0.5, force: 1, name: "Light air"},
+		{speed: 5.4, force: 3, name: "Gentle breeze"},
+		{speed: 5.5, force: 4, name: "Moderate breeze"},
+		{speed: 20.8, force: 9, name: "Strong gale"},
+		{speed: 40, force: 12, name: "Hurricane force"},
+	}
+
+	for _, test := range tests {
+		got := (&Weather{WS: test.speed}).Beaufort()
+		if test.force != got || test.name != got.String() {
+			t.Errorf("expected %d %s for %v m/s , got %d %s", test.force, test.name, test.speed, got, got)
+		}
+	}
+
+	if got := (&Forecast{WS: 11}).Beaufort(); got != 6 {
+		t.Errorf("expected 6 , got %d", got)
+	}
+}
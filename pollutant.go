@@ -0,0 +1,51 @@
+package airvisual
+
+import (
+	"github.com/johanavril/airvisual/aqi"
+)
+
+// Pollutant is a pollutant code as used by MAINUS, MAINCN and the unit fields of Pollution,
+// it provides the pollutant's Name, Formula and Unit
+type Pollutant = aqi.Pollutant
+
+// Pollutants reported by AirVisual
+const (
+	PM25 = aqi.PM25 // fine particulate matter, in µg/m³
+	PM10 = aqi.PM10 // coarse particulate matter, in µg/m³
+	O3   = aqi.O3   // ozone, in ppb
+	NO2  = aqi.NO2  // nitrogen dioxide, in ppb
+	SO2  = aqi.SO2  // sulfur dioxide, in ppb
+	CO   = aqi.CO   // carbon monoxide, in ppm
+)
+
+// Get return the unit of a pollutant, or nil if it is absent from the record or unknown
+func (p *Pollution) Get(pollutant Pollutant) *Unit {
+	switch pollutant {
+	case PM25:
+		return p.P2
+	case PM10:
+		return p.P1
+	case O3:
+		return p.O3
+	case NO2:
+		return p.N2
+	case SO2:
+		return p.S2
+	case CO:
+		return p.CO
+	}
+
+	return nil
+}
+
+// Pollutants return the pollutants present in the record, in the order of aqi.Pollutants
+func (p *Pollution) Pollutants() []Pollutant {
+	var pollutants []Pollutant
+	for _, pollutant := range aqi.Pollutants {
+		if p.Get(pollutant) != nil {
+			pollutants = append(pollutants, pollutant)
+		}
+	}
+
+	return pollutants
+}
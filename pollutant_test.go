@@ -0,0 +1,79 @@
+package airvisual
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPollutant(t *testing.T) {
+	tests := []struct {
+		pollutant Pollutant
+		valid     bool
+		name      string
+		formula   string
+		unit      string
+	}{
+		{pollutant: PM25, valid: true, name: "Fine particulate matter", formula: "PM2.5", unit: "µg/m³"},
+		{pollutant: O3, valid: true, name: "Ozone", formula: "O₃", unit: "ppb"},
+		{pollutant: CO, valid: true, name: "Carbon monoxide", formula: "CO", unit: "ppm"},
+		{pollutant: "xx", valid: false, name: "xx", formula: "xx", unit: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.pollutant), func(t *testing.T) {
+			if got := test.pollutant.Valid(); test.valid != got {
+				t.Errorf("expected valid %t , got %t", test.valid, got)
+			}
+			if got := test.pollutant.Name(); test.name != got {
+				t.Errorf("expected name %s , got %s", test.name, got)
+			}
+			if got := test.pollutant.Formula(); test.formula != got {
+				t.Errorf("expected formula %s , got %s", test.formula, got)
+			}
+			if got := test.pollutant.Unit(); test.unit != got {
+				t.Errorf("expected unit %s , got %s", test.unit, got)
+			}
+		})
+	}
+}
+
+func TestPollutionGet(t *testing.T) {
+	pollution := &Pollution{
+		P2: &Unit{CONC: 21},
+		N2: &Unit{CONC: 12},
+		CO: &Unit{CONC: 0.2},
+	}
+
+	if got := pollution.Get(PM25); got != pollution.P2 {
+		t.Errorf("expected %#v , got %#v", pollution.P2, got)
+	}
+	if got := pollution.Get(NO2); got != pollution.N2 {
+		t.Errorf("expected %#v , got %#v", pollution.N2, got)
+	}
+	if got := pollution.Get(SO2); got != nil {
+		t.Errorf("expected nil , got %#v", got)
+	}
+	if got := pollution.Get("xx"); got != nil {
+		t.Errorf("expected nil , got %#v", got)
+	}
+
+	want := []Pollutant{PM25, NO2, CO}
+	if got := pollution.Pollutants(); !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %v , got %v", want, got)
+	}
+}
+
+func TestPollutionMainPollutant(t *testing.T) {
+	var pollution Pollution
+	if err := json.Unmarshal([]byte(`{"aqius":70,"mainus":"p2","aqicn":30,"maincn":"p1"}`), &pollution); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if pollution.MAINUS != PM25 || pollution.MAINCN != PM10 {
+		t.Errorf("expected %s and %s , got %s and %s", PM25, PM10, pollution.MAINUS, pollution.MAINCN)
+	}
+	if got := pollution.MAINUS.Name(); got != "Fine particulate matter" {
+		t.Errorf("expected Fine particulate matter , got %s", got)
+	}
+}
@@ -0,0 +1,194 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitExceeded is returned by a fail fast rate limiter when the quota is used up
+var ErrRateLimitExceeded = errors.New("client side rate limit exceeded")
+
+// RateLimit contains number of calls allowed per time window, 0 means unlimited
+type RateLimit struct {
+	PerMinute int
+	PerDay    int
+	PerMonth  int  // a month is counted as 30 days
+	Wait      bool // block until a call is available instead of failing with ErrRateLimitExceeded
+}
+
+// CommunityPlan return rate limit of AirVisual's Community plan
+func CommunityPlan() RateLimit {
+	return RateLimit{PerMinute: 5, PerDay: 500, PerMonth: 10000, Wait: true}
+}
+
+// StartupPlan return rate limit of AirVisual's Startup plan
+func StartupPlan() RateLimit {
+	return RateLimit{PerMinute: 100, PerDay: 10000, PerMonth: 300000, Wait: true}
+}
+
+// EnterprisePlan return rate limit of AirVisual's Enterprise plan
+func EnterprisePlan() RateLimit {
+	return RateLimit{PerMinute: 1000, PerDay: 100000, PerMonth: 3000000, Wait: true}
+}
+
+// WithRateLimit set client side rate limit shared by every method of airvisual client
+func WithRateLimit(limit RateLimit) Option {
+	return func(c *Client) {
+		c.limiter = newLimiter(limit, time.Now)
+	}
+}
+
+// Quota contains remaining calls per time window, -1 means the window is not limited
+type Quota struct {
+	Minute int
+	Day    int
+	Month  int
+}
+
+// Quota return remaining calls allowed by the client side rate limit
+func (c *Client) Quota() Quota {
+	if c.limiter == nil {
+		return Quota{Minute: -1, Day: -1, Month: -1}
+	}
+
+	return c.limiter.quota()
+}
+
+type bucket struct {
+	capacity float64
+	tokens   float64
+	rate     float64 // tokens per nanosecond
+}
+
+func newBucket(limit int, window time.Duration) *bucket {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &bucket{
+		capacity: float64(limit),
+		tokens:   float64(limit),
+		rate:     float64(limit) / float64(window),
+	}
+}
+
+func (b *bucket) refill(elapsed time.Duration) {
+	b.tokens = math.Min(b.capacity, b.tokens+float64(elapsed)*b.rate)
+}
+
+func (b *bucket) wait() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration(math.Ceil((1 - b.tokens) / b.rate))
+}
+
+func (b *bucket) remaining() int {
+	if b == nil {
+		return -1
+	}
+
+	return int(b.tokens)
+}
+
+type limiter struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	last    time.Time
+	wait    bool
+	buckets []*bucket
+	minute  *bucket
+	day     *bucket
+	month   *bucket
+}
+
+func newLimiter(limit RateLimit, now func() time.Time) *limiter {
+	l := &limiter{
+		now:    now,
+		last:   now(),
+		wait:   limit.Wait,
+		minute: newBucket(limit.PerMinute, time.Minute),
+		day:    newBucket(limit.PerDay, 24*time.Hour),
+		month:  newBucket(limit.PerMonth, 30*24*time.Hour),
+	}
+
+	for _, b := range []*bucket{l.minute, l.day, l.month} {
+		if b != nil {
+			l.buckets = append(l.buckets, b)
+		}
+	}
+
+	return l
+}
+
+func (l *limiter) refill() {
+	now := l.now()
+	elapsed := now.Sub(l.last)
+	l.last = now
+
+	for _, b := range l.buckets {
+		b.refill(elapsed)
+	}
+}
+
+// reserve take a token from every bucket if all of them have one,
+// otherwise it return how long to wait until they do
+func (l *limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+
+	var wait time.Duration
+	for _, b := range l.buckets {
+		if w := b.wait(); w > wait {
+			wait = w
+		}
+	}
+	if wait > 0 {
+		return wait
+	}
+
+	for _, b := range l.buckets {
+		b.tokens--
+	}
+
+	return 0
+}
+
+func (l *limiter) acquire(ctx context.Context) error {
+	for {
+		wait := l.reserve()
+		if wait == 0 {
+			return nil
+		}
+		if !l.wait {
+			return ErrRateLimitExceeded
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (l *limiter) quota() Quota {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+
+	return Quota{
+		Minute: l.minute.remaining(),
+		Day:    l.day.remaining(),
+		Month:  l.month.remaining(),
+	}
+}
@@ -0,0 +1,119 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.now
+}
+
+func TestLimiter(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   RateLimit
+		calls   int
+		advance time.Duration
+		want    Quota
+		err     error
+	}{
+		{
+			name:  "within quota",
+			limit: RateLimit{PerMinute: 5, PerMonth: 10000},
+			calls: 3,
+			want:  Quota{Minute: 2, Day: -1, Month: 9997},
+		},
+		{
+			name:  "minute quota exceeded",
+			limit: RateLimit{PerMinute: 5, PerMonth: 10000},
+			calls: 6,
+			want:  Quota{Minute: 0, Day: -1, Month: 9995},
+			err:   ErrRateLimitExceeded,
+		},
+		{
+			name:    "minute quota refilled",
+			limit:   RateLimit{PerMinute: 5, PerMonth: 10000},
+			calls:   5,
+			advance: 30 * time.Second,
+			want:    Quota{Minute: 2, Day: -1, Month: 9995},
+		},
+		{
+			name:    "day quota does not refill within a minute",
+			limit:   RateLimit{PerMinute: 5, PerDay: 5},
+			calls:   5,
+			advance: time.Minute,
+			want:    Quota{Minute: 5, Day: 0, Month: -1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)}
+			l := newLimiter(test.limit, clock.Now)
+
+			var err error
+			for i := 0; i < test.calls; i++ {
+				err = l.acquire(context.Background())
+			}
+			clock.now = clock.now.Add(test.advance)
+
+			got := l.quota()
+
+			if test.want != got {
+				t.Errorf("expected %#v , got %#v", test.want, got)
+			}
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected %v , got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	l := newLimiter(RateLimit{PerMinute: 1, Wait: true}, time.Now)
+
+	err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error , got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = l.acquire(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v , got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestClientQuota(t *testing.T) {
+	client, server := mockClientServer(`{"status": "success", "data": []}`)
+	defer server.Close()
+
+	if want, got := (Quota{Minute: -1, Day: -1, Month: -1}), client.Quota(); want != got {
+		t.Errorf("expected %#v , got %#v", want, got)
+	}
+
+	WithRateLimit(RateLimit{PerMinute: 2})(client)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Countries(); err != nil {
+			t.Fatalf("expected no error , got %v", err)
+		}
+	}
+
+	_, err := client.States("USA")
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("expected %v , got %v", ErrRateLimitExceeded, err)
+	}
+	if want, got := (Quota{Minute: 0, Day: -1, Month: -1}), client.Quota(); want != got {
+		t.Errorf("expected %#v , got %#v", want, got)
+	}
+}
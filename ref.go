@@ -0,0 +1,258 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidRef is returned when a location string cannot be parsed into a reference
+var ErrInvalidRef = errors.New("invalid location reference")
+
+// splitRef split a comma separated location string, trimming and collapsing spaces of every part.
+// A comma or backslash escaped with a backslash is kept in the name, e.g. "Washington\, D.C."
+func splitRef(s string) []string {
+	var parts []string
+	var b strings.Builder
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			parts = append(parts, b.String())
+			b.Reset()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if escaped {
+		b.WriteRune('\\')
+	}
+	parts = append(parts, b.String())
+
+	for i, part := range parts {
+		parts[i] = strings.Join(strings.Fields(part), " ")
+	}
+
+	return parts
+}
+
+var refEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`)
+
+// escapeRef escape the names of a reference so that splitRef return them unchanged
+func escapeRef(names ...string) []string {
+	escaped := make([]string, len(names))
+	for i, name := range names {
+		escaped[i] = refEscaper.Replace(name)
+	}
+
+	return escaped
+}
+
+func joinRef(parts ...string) string {
+	var names []string
+	for _, part := range parts {
+		if part != "" {
+			names = append(names, part)
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// ParseCityRef parse "city, state, country" or "city, country" into a reference, names are not checked,
+// use Resolver.ParseCity to get the exact names expected by AirVisual. The state of "city, country" is empty,
+// such a reference must be resolved with Resolver.ResolveCity before calling CityByRef or StationsByRef
+func ParseCityRef(s string) (CityRef, error) {
+	parts := splitRef(s)
+	for _, part := range parts {
+		if part == "" {
+			return CityRef{}, fmt.Errorf("%w: empty name in %q", ErrInvalidRef, s)
+		}
+	}
+
+	switch len(parts) {
+	case 2:
+		return CityRef{City: parts[0], Country: parts[1]}, nil
+	case 3:
+		return CityRef{City: parts[0], State: parts[1], Country: parts[2]}, nil
+	}
+
+	return CityRef{}, fmt.Errorf("%w: expected \"city, state, country\", got %q", ErrInvalidRef, s)
+}
+
+// ParseStationRef parse "station, city, state, country" into a reference, commas before the city are kept in
+// the station name
+func ParseStationRef(s string) (StationRef, error) {
+	parts := splitRef(s)
+	if len(parts) < 4 {
+		return StationRef{}, fmt.Errorf("%w: expected \"station, city, state, country\", got %q", ErrInvalidRef, s)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return StationRef{}, fmt.Errorf("%w: empty name in %q", ErrInvalidRef, s)
+		}
+	}
+
+	n := len(parts)
+
+	return StationRef{
+		Station: strings.Join(parts[:n-3], ", "),
+		City:    parts[n-3],
+		State:   parts[n-2],
+		Country: parts[n-1],
+	}, nil
+}
+
+// String return the reference as "city, state, country"
+func (r CityRef) String() string {
+	return joinRef(r.City, r.State, r.Country)
+}
+
+// MarshalText encode the reference as "city, state, country", escaping commas inside names with a backslash
+func (r CityRef) MarshalText() ([]byte, error) {
+	return []byte(joinRef(escapeRef(r.City, r.State, r.Country)...)), nil
+}
+
+// UnmarshalText decode a reference with ParseCityRef, e.g. from a configuration file
+func (r *CityRef) UnmarshalText(text []byte) error {
+	ref, err := ParseCityRef(string(text))
+	if err != nil {
+		return err
+	}
+	*r = ref
+
+	return nil
+}
+
+// String return the reference as "station, city, state, country"
+func (r StationRef) String() string {
+	return joinRef(r.Station, r.City, r.State, r.Country)
+}
+
+// MarshalText encode the reference as "station, city, state, country", escaping commas inside names with a backslash
+func (r StationRef) MarshalText() ([]byte, error) {
+	return []byte(joinRef(escapeRef(r.Station, r.City, r.State, r.Country)...)), nil
+}
+
+// UnmarshalText decode a reference with ParseStationRef, e.g. from a configuration file
+func (r *StationRef) UnmarshalText(text []byte) error {
+	ref, err := ParseStationRef(string(text))
+	if err != nil {
+		return err
+	}
+	*r = ref
+
+	return nil
+}
+
+// ParseCity parse a location string with ParseCityRef and resolve it into exact names, shortened forms such as
+// "LA, CA, US" are resolved through the resolver's aliases. A string without state, e.g. "Paris, France", is
+// searched in every state of the country, see ResolveCity for the API calls it costs
+func (r *Resolver) ParseCity(ctx context.Context, s string) (CityRef, error) {
+	ref, err := ParseCityRef(s)
+	if err != nil {
+		return CityRef{}, err
+	}
+
+	return r.ResolveCity(ctx, ref.City, ref.State, ref.Country)
+}
+
+// ParseStation parse a location string with ParseStationRef and resolve it into exact names
+func (r *Resolver) ParseStation(ctx context.Context, s string) (StationRef, error) {
+	ref, err := ParseStationRef(s)
+	if err != nil {
+		return StationRef{}, err
+	}
+
+	return r.ResolveStation(ctx, ref.Station, ref.City, ref.State, ref.Country)
+}
+
+// ResolveStation return the exact names of a station, its city, state and country. The station name is kept as
+// is when the source does not list the stations of the city.
+func (r *Resolver) ResolveStation(ctx context.Context, station, city, state, country string) (StationRef, error) {
+	ref, err := r.ResolveCity(ctx, city, state, country)
+	if err != nil {
+		return StationRef{}, err
+	}
+
+	stations, err := r.source.stations(ctx, ref.City, ref.State, ref.Country)
+	if err != nil {
+		return StationRef{}, fmt.Errorf("unable to resolve station: %w", err)
+	}
+	if len(stations) > 0 {
+		station, err = r.best(StationAlias, station, stations)
+		if err != nil {
+			return StationRef{}, err
+		}
+	}
+
+	return StationRef{Station: station, City: ref.City, State: ref.State, Country: ref.Country}, nil
+}
+
+// Validate return ErrInvalidRef if a name of the reference is empty, e.g. the state of "city, country"
+func (r CityRef) Validate() error {
+	if r.City == "" || r.State == "" || r.Country == "" {
+		return fmt.Errorf("%w: %q has an empty name", ErrInvalidRef, r.String())
+	}
+
+	return nil
+}
+
+// Validate return ErrInvalidRef if a name of the reference is empty
+func (r StationRef) Validate() error {
+	if r.Station == "" || r.City == "" || r.State == "" || r.Country == "" {
+		return fmt.Errorf("%w: %q has an empty name", ErrInvalidRef, r.String())
+	}
+
+	return nil
+}
+
+// CityByRef is like City but takes a reference, it return ErrInvalidRef without calling the API if a name is empty
+func (c *Client) CityByRef(ref CityRef) (*City, error) {
+	return c.CityByRefContext(context.Background(), ref)
+}
+
+// CityByRefContext is like CityByRef but takes a context to cancel the request
+func (c *Client) CityByRefContext(ctx context.Context, ref CityRef) (*City, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.CityContext(ctx, ref.City, ref.State, ref.Country)
+}
+
+// StationsByRef is like Stations but takes a reference, it return ErrInvalidRef without calling the API if a name
+// is empty
+func (c *Client) StationsByRef(ref CityRef) ([]*Stations, error) {
+	return c.StationsByRefContext(context.Background(), ref)
+}
+
+// StationsByRefContext is like StationsByRef but takes a context to cancel the request
+func (c *Client) StationsByRefContext(ctx context.Context, ref CityRef) ([]*Stations, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.StationsContext(ctx, ref.City, ref.State, ref.Country)
+}
+
+// StationByRef is like Station but takes a reference, it return ErrInvalidRef without calling the API if a name
+// is empty
+func (c *Client) StationByRef(ref StationRef) (*Station, error) {
+	return c.StationByRefContext(context.Background(), ref)
+}
+
+// StationByRefContext is like StationByRef but takes a context to cancel the request
+func (c *Client) StationByRefContext(ctx context.Context, ref StationRef) (*Station, error) {
+	if err := ref.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.StationContext(ctx, ref.Station, ref.City, ref.State, ref.Country)
+}
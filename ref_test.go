@@ -0,0 +1,241 @@
+package airvisual
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestParseCityRef(t *testing.T) {
+	tests := []struct {
+		s    string
+		want CityRef
+		err  error
+	}{
+		{s: "Los Angeles, California, USA", want: CityRef{"Los Angeles", "California", "USA"}},
+		{s: "  Los   Angeles ,California,  USA ", want: CityRef{"Los Angeles", "California", "USA"}},
+		{s: "Sao Paulo, Brazil", want: CityRef{City: "Sao Paulo", Country: "Brazil"}},
+		{s: "Los Angeles", err: ErrInvalidRef},
+		{s: "Los Angeles, , USA", err: ErrInvalidRef},
+		{s: "a, b, c, d", err: ErrInvalidRef},
+	}
+
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			got, err := ParseCityRef(test.s)
+
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseStationRef(t *testing.T) {
+	tests := []struct {
+		s    string
+		want StationRef
+		err  error
+	}{
+		{s: "US Embassy in Beijing, Beijing, Beijing, China", want: StationRef{"US Embassy in Beijing", "Beijing", "Beijing", "China"}},
+		{s: "Park, North Gate, Beijing, Beijing, China", want: StationRef{"Park, North Gate", "Beijing", "Beijing", "China"}},
+		{s: "Beijing, Beijing, China", err: ErrInvalidRef},
+		{s: "US Embassy in Beijing, Beijing, , China", err: ErrInvalidRef},
+	}
+
+	for _, test := range tests {
+		t.Run(test.s, func(t *testing.T) {
+			got, err := ParseStationRef(test.s)
+
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestRefText(t *testing.T) {
+	config := struct {
+		City    CityRef    `json:"city"`
+		Station StationRef `json:"station"`
+	}{}
+
+	data := `{"city":"Los Angeles, California, USA","station":"US Embassy in Beijing, Beijing, Beijing, China"}`
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (CityRef{"Los Angeles", "California", "USA"}); want != config.City {
+		t.Errorf("expected %v , got %v", want, config.City)
+	}
+
+	got, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data != string(got) {
+		t.Errorf("expected %s , got %s", data, got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"city":"Los Angeles"}`), &config); !errors.Is(err, ErrInvalidRef) {
+		t.Errorf("expected %v , got %v", ErrInvalidRef, err)
+	}
+	if got := (CityRef{City: "Sao Paulo", Country: "Brazil"}).String(); got != "Sao Paulo, Brazil" {
+		t.Errorf("expected Sao Paulo, Brazil , got %s", got)
+	}
+}
+
+func TestRefTextRoundTrip(t *testing.T) {
+	config := struct {
+		City    CityRef    `json:"city"`
+		Station StationRef `json:"station"`
+	}{
+		City:    CityRef{City: "Washington", State: "Washington, D.C.", Country: "USA"},
+		Station: StationRef{Station: `Park, North Gate \ East`, City: "Washington", State: "Washington, D.C.", Country: "USA"},
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := config
+	got.City, got.Station = CityRef{}, StationRef{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error decoding %s: %v", data, err)
+	}
+	if config != got {
+		t.Errorf("expected %v , got %v", config, got)
+	}
+
+	ref, err := ParseCityRef(`Washington, Washington\, D.C., USA`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.City != ref {
+		t.Errorf("expected %v , got %v", config.City, ref)
+	}
+}
+
+func TestResolverParse(t *testing.T) {
+	catalog := resolverCatalog()
+	catalog.Countries[2].States[0].Cities[0].Stations = []*CatalogStation{{Name: "Los Angeles - N. Main St."}}
+	resolver := NewCatalogResolver(catalog)
+
+	city, err := resolver.ParseCity(context.Background(), "LA, CA, US")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (CityRef{"Los Angeles", "California", "USA"}); want != city {
+		t.Errorf("expected %v , got %v", want, city)
+	}
+
+	station, err := resolver.ParseStation(context.Background(), "los angeles n main st, la, california, usa")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (StationRef{"Los Angeles - N. Main St.", "Los Angeles", "California", "USA"}); want != station {
+		t.Errorf("expected %v , got %v", want, station)
+	}
+
+	station, err = resolver.ParseStation(context.Background(), "Buffalo Airport, Buffalo, NY, USA")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (StationRef{"Buffalo Airport", "Buffalo", "New York", "USA"}); want != station {
+		t.Errorf("expected station name to be kept when stations are not listed , got %v", station)
+	}
+
+	if _, err := resolver.ParseCity(context.Background(), "Fresno, CA, US"); !errors.Is(err, ErrNoMatch) {
+		t.Errorf("expected %v , got %v", ErrNoMatch, err)
+	}
+}
+
+func TestResolverParseCodes(t *testing.T) {
+	resolver := NewCatalogResolver(&Catalog{Countries: []*CatalogCountry{
+		{Name: "Canada", States: []*CatalogState{{Name: "Ontario", Cities: []*CatalogCity{{Name: "Toronto"}}}}},
+		{Name: "Germany", States: []*CatalogState{{Name: "Berlin", Cities: []*CatalogCity{{Name: "Berlin"}}}}},
+		{Name: "India", States: []*CatalogState{{Name: "Delhi", Cities: []*CatalogCity{{Name: "New Delhi"}}}}},
+		{Name: "Laos", States: []*CatalogState{{Name: "Vientiane", Cities: []*CatalogCity{{Name: "Vientiane"}}}}},
+		{Name: "USA", States: []*CatalogState{
+			{Name: "California", Cities: []*CatalogCity{{Name: "Los Angeles"}}},
+			{Name: "Delaware", Cities: []*CatalogCity{{Name: "Wilmington"}}},
+			{Name: "Indiana", Cities: []*CatalogCity{{Name: "Indianapolis"}}},
+			{Name: "Louisiana", Cities: []*CatalogCity{{Name: "New Orleans"}}},
+		}},
+	}})
+
+	tests := map[string]CityRef{
+		"LA, CA, US":                  {"Los Angeles", "California", "USA"},
+		"New Orleans, LA, US":         {"New Orleans", "Louisiana", "USA"},
+		"Wilmington, DE, US":          {"Wilmington", "Delaware", "USA"},
+		"Indianapolis, IN, US":        {"Indianapolis", "Indiana", "USA"},
+		"Toronto, ON, CA":             {"Toronto", "Ontario", "Canada"},
+		"Berlin, Berlin, DE":          {"Berlin", "Berlin", "Germany"},
+		"New Delhi, Delhi, IN":        {"New Delhi", "Delhi", "India"},
+		"Vientiane, Vientiane, LA":    {"Vientiane", "Vientiane", "Laos"},
+		"Los Angeles, California, us": {"Los Angeles", "California", "USA"},
+	}
+
+	for s, want := range tests {
+		t.Run(s, func(t *testing.T) {
+			got, err := resolver.ParseCity(context.Background(), s)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want != got {
+				t.Errorf("expected %v , got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestClientByRef(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Write([]byte(`{"status":"success","data":null}`))
+	}))
+	defer server.Close()
+
+	client := New("API Key", WithBaseEndpoint(server.URL))
+	city := CityRef{"Beijing", "Beijing", "China"}
+
+	if _, err := client.CityByRef(city); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.StationsByRef(city); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.StationByRef(StationRef{"US Embassy in Beijing", "Beijing", "Beijing", "China"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, q := range queries {
+		if q.Get("city") != "Beijing" || q.Get("state") != "Beijing" || q.Get("country") != "China" {
+			t.Errorf("unexpected query %d: %v", i, q)
+		}
+	}
+	if _, err := client.CityByRef(CityRef{City: "Paris", Country: "France"}); !errors.Is(err, ErrInvalidRef) {
+		t.Errorf("expected %v , got %v", ErrInvalidRef, err)
+	}
+	if _, err := client.StationsByRef(CityRef{City: "Paris", Country: "France"}); !errors.Is(err, ErrInvalidRef) {
+		t.Errorf("expected %v , got %v", ErrInvalidRef, err)
+	}
+	if _, err := client.StationByRef(StationRef{City: "Beijing", State: "Beijing", Country: "China"}); !errors.Is(err, ErrInvalidRef) {
+		t.Errorf("expected %v , got %v", ErrInvalidRef, err)
+	}
+
+	if len(queries) != 3 || queries[2].Get("station") != "US Embassy in Beijing" {
+		t.Errorf("unexpected queries %v", queries)
+	}
+}
@@ -0,0 +1,471 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Errors returned when a name cannot be resolved
+var (
+	ErrNoMatch   = errors.New("no matching location")
+	ErrAmbiguous = errors.New("ambiguous location")
+)
+
+// foldGroups contains the letters folded into each ASCII replacement
+var foldGroups = map[string]string{
+	"a":  "àáâãäåāăąǎǟǡǻȁȃȧḁạảấầẩẫậắằẳẵặ",
+	"b":  "ḃḅḇ",
+	"c":  "çćĉċčḉ",
+	"d":  "ďḋḍḏḑḓđð",
+	"e":  "èéêëēĕėęěȅȇȩḕḗḙḛḝẹẻẽếềểễệ",
+	"f":  "ḟ",
+	"g":  "ĝğġģǧǵḡ",
+	"h":  "ĥȟḣḥḧḩḫẖħ",
+	"i":  "ìíîïĩīĭįǐȉȋḭḯỉịı",
+	"j":  "ĵǰ",
+	"k":  "ķǩḱḳḵ",
+	"l":  "ĺļľḷḹḻḽł",
+	"m":  "ḿṁṃ",
+	"n":  "ñńņňǹṅṇṉṋ",
+	"o":  "òóôõöōŏőơǒǫǭȍȏȫȭȯȱṍṏṑṓọỏốồổỗộớờởỡợø",
+	"p":  "ṕṗ",
+	"r":  "ŕŗřȑȓṙṛṝṟ",
+	"s":  "śŝşšșṡṣṥṧṩ",
+	"t":  "ţťțṫṭṯṱẗ",
+	"u":  "ùúûüũūŭůűųưǔǖǘǚǜȕȗṳṵṷṹṻụủứừửữự",
+	"v":  "ṽṿ",
+	"w":  "ŵẁẃẅẇẉẘ",
+	"x":  "ẋẍ",
+	"y":  "ýÿŷȳẏẙỳỵỷỹ",
+	"z":  "źżžẑẓẕ",
+	"ss": "ß",
+	"ae": "æ",
+	"oe": "œ",
+	"th": "þ",
+}
+
+var fold = map[rune]string{}
+
+func init() {
+	for replacement, letters := range foldGroups {
+		for _, r := range letters {
+			fold[r] = replacement
+		}
+	}
+}
+
+// NormalizeName return the form names are compared in: lower case, without diacritics, with punctuation
+// replaced by spaces, e.g. "São Paulo" and "sao-paulo" both become "sao paulo"
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case fold[r] != "":
+			b.WriteString(fold[r])
+		case unicode.Is(unicode.Mn, r):
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Match is a candidate name and its score, from 0 to 1
+type Match struct {
+	Name  string
+	Score float64
+}
+
+// matchScore score a candidate against a normalized query, by edit distance, with a bonus for candidates
+// containing every word of the query, e.g. "ho chi minh" and "Ho Chi Minh City"
+func matchScore(query, candidate string) float64 {
+	candidate = NormalizeName(candidate)
+	if query == candidate {
+		return 1
+	}
+
+	score := similarity(query, candidate)
+
+	words := map[string]bool{}
+	for _, w := range strings.Fields(candidate) {
+		words[w] = true
+	}
+	contained := query != ""
+	for _, w := range strings.Fields(query) {
+		contained = contained && words[w]
+	}
+	if contained {
+		if bonus := 0.8 + 0.19*float64(len(query))/float64(len(candidate)); bonus > score {
+			score = bonus
+		}
+	}
+
+	return score
+}
+
+// resolverSource list the names a resolver choose from
+type resolverSource interface {
+	countries(ctx context.Context) ([]string, error)
+	states(ctx context.Context, country string) ([]string, error)
+	cities(ctx context.Context, state, country string) ([]string, error)
+	stations(ctx context.Context, city, state, country string) ([]string, error)
+}
+
+// Resolver resolve names typed by users into the exact names expected by AirVisual, comparing them
+// case and diacritic insensitively, ranking candidates by edit distance and trying known aliases.
+// A resolver is safe for concurrent use
+type Resolver struct {
+	source   resolverSource
+	mu       sync.RWMutex
+	aliases  map[AliasKind]map[string]string
+	MinScore float64 // candidates scoring below are rejected, from 0 to 1, defaults to 0.6
+}
+
+// NewResolver return a resolver choosing from the Countries, States and Cities lists of api, lists are fetched
+// when first needed and kept for the life of the resolver
+func NewResolver(api API) *Resolver {
+	return newResolver(&apiSource{api: api, lists: map[string][]string{}, pending: map[string]*pendingList{}})
+}
+
+// NewCatalogResolver return a resolver choosing from the locations of a catalog, e.g. one built with Crawl
+func NewCatalogResolver(catalog *Catalog) *Resolver {
+	return newResolver(catalogSource{catalog})
+}
+
+func newResolver(source resolverSource) *Resolver {
+	aliases := map[AliasKind]map[string]string{}
+	for kind, names := range defaultAliases {
+		aliases[kind] = map[string]string{}
+		for alias, name := range names {
+			aliases[kind][alias] = name
+		}
+	}
+
+	return &Resolver{source: source, aliases: aliases, MinScore: 0.6}
+}
+
+// AddAlias make the resolver try name when users type alias for a location of that kind,
+// e.g. AddAlias(CityAlias, "Big Apple", "New York City")
+func (r *Resolver) AddAlias(kind AliasKind, alias, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.aliases[kind] == nil {
+		r.aliases[kind] = map[string]string{}
+	}
+	r.aliases[kind][NormalizeName(alias)] = name
+}
+
+// Rank return the candidates scoring at least MinScore against the query or its alias of that kind, best first
+func (r *Resolver) Rank(kind AliasKind, query string, candidates []string) []Match {
+	queries := []string{NormalizeName(query)}
+	r.mu.RLock()
+	if alias, ok := r.aliases[kind][queries[0]]; ok {
+		queries = append(queries, NormalizeName(alias))
+	}
+	r.mu.RUnlock()
+
+	var matches []Match
+	for _, candidate := range candidates {
+		best := 0.0
+		for _, q := range queries {
+			if score := matchScore(q, candidate); score > best {
+				best = score
+			}
+		}
+		if best >= r.MinScore {
+			matches = append(matches, Match{Name: candidate, Score: best})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+
+	return matches
+}
+
+// best return the best match, failing if there is none or if several candidates share the best score
+func (r *Resolver) best(kind AliasKind, query string, candidates []string) (string, error) {
+	matches := r.Rank(kind, query, candidates)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("%w: %s %q", ErrNoMatch, kind, query)
+	}
+	if len(matches) > 1 && matches[1].Score == matches[0].Score {
+		return "", fmt.Errorf("%w: %s %q matches %q and %q", ErrAmbiguous, kind, query, matches[0].Name, matches[1].Name)
+	}
+
+	return matches[0].Name, nil
+}
+
+// ResolveCountry return the exact name of a country
+func (r *Resolver) ResolveCountry(ctx context.Context, country string) (string, error) {
+	countries, err := r.source.countries(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve country: %w", err)
+	}
+
+	return r.best(CountryAlias, country, countries)
+}
+
+// ResolveState return the exact names of a state and its country
+func (r *Resolver) ResolveState(ctx context.Context, state, country string) (string, string, error) {
+	country, err := r.ResolveCountry(ctx, country)
+	if err != nil {
+		return "", "", err
+	}
+
+	states, err := r.source.states(ctx, country)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to resolve state: %w", err)
+	}
+
+	state, err = r.best(StateAlias, state, states)
+	if err != nil {
+		return "", "", err
+	}
+
+	return state, country, nil
+}
+
+// ResolveCity return the exact names of a city, its state and country, ready for City or Stations. When state
+// is empty the city is searched in every state of the country: a resolver created with NewResolver then makes one
+// Cities call per state, about 50 for the USA, which blocks for minutes under the Community plan rate limit.
+func (r *Resolver) ResolveCity(ctx context.Context, city, state, country string) (CityRef, error) {
+	if state != "" {
+		state, country, err := r.ResolveState(ctx, state, country)
+		if err != nil {
+			return CityRef{}, err
+		}
+
+		cities, err := r.source.cities(ctx, state, country)
+		if err != nil {
+			return CityRef{}, fmt.Errorf("unable to resolve city: %w", err)
+		}
+
+		city, err = r.best(CityAlias, city, cities)
+		if err != nil {
+			return CityRef{}, err
+		}
+
+		return CityRef{City: city, State: state, Country: country}, nil
+	}
+
+	country, err := r.ResolveCountry(ctx, country)
+	if err != nil {
+		return CityRef{}, err
+	}
+	states, err := r.source.states(ctx, country)
+	if err != nil {
+		return CityRef{}, fmt.Errorf("unable to resolve city: %w", err)
+	}
+
+	// names are made unique by their state so that the best match can be traced back to it
+	var candidates []string
+	stateOf := map[string]string{}
+	for _, s := range states {
+		cities, err := r.source.cities(ctx, s, country)
+		if err != nil {
+			return CityRef{}, fmt.Errorf("unable to resolve city: %w", err)
+		}
+		for _, c := range cities {
+			if _, ok := stateOf[c]; ok {
+				continue
+			}
+			stateOf[c] = s
+			candidates = append(candidates, c)
+		}
+	}
+
+	city, err = r.best(CityAlias, city, candidates)
+	if err != nil {
+		return CityRef{}, err
+	}
+
+	return CityRef{City: city, State: stateOf[city], Country: country}, nil
+}
+
+// apiSource list names with API calls, caching every list
+type apiSource struct {
+	api     API
+	mu      sync.Mutex
+	lists   map[string][]string
+	pending map[string]*pendingList
+}
+
+// pendingList is a list being fetched, done is closed once names and err are set
+type pendingList struct {
+	done  chan struct{}
+	names []string
+	err   error
+}
+
+// list return the cached list of the key or fetch it. The lock is not held while fetching, so cached lists stay
+// available while a fetch waits for the rate limiter, and concurrent callers of the same key share one fetch
+func (s *apiSource) list(ctx context.Context, key string, fetch func() ([]string, error)) ([]string, error) {
+	for {
+		s.mu.Lock()
+		if names, ok := s.lists[key]; ok {
+			s.mu.Unlock()
+			return names, nil
+		}
+		p, ok := s.pending[key]
+		if !ok {
+			break
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-p.done:
+			// a failed fetch, e.g. canceled by its caller's context, is retried with ours
+			if p.err == nil {
+				return p.names, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	p := &pendingList{done: make(chan struct{})}
+	s.pending[key] = p
+	s.mu.Unlock()
+
+	p.names, p.err = fetch()
+
+	s.mu.Lock()
+	delete(s.pending, key)
+	if p.err == nil {
+		s.lists[key] = p.names
+	}
+	s.mu.Unlock()
+	close(p.done)
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return p.names, nil
+}
+
+func (s *apiSource) countries(ctx context.Context) ([]string, error) {
+	return s.list(ctx, indexKey(), func() ([]string, error) {
+		countries, err := s.api.CountriesContext(ctx)
+		var names []string
+		for _, c := range countries {
+			names = append(names, c.Country)
+		}
+		return names, err
+	})
+}
+
+func (s *apiSource) states(ctx context.Context, country string) ([]string, error) {
+	return s.list(ctx, indexKey(country), func() ([]string, error) {
+		states, err := s.api.StatesContext(ctx, country)
+		var names []string
+		for _, st := range states {
+			names = append(names, st.State)
+		}
+		return names, err
+	})
+}
+
+func (s *apiSource) cities(ctx context.Context, state, country string) ([]string, error) {
+	return s.list(ctx, indexKey(country, state), func() ([]string, error) {
+		cities, err := s.api.CitiesContext(ctx, state, country)
+		var names []string
+		for _, c := range cities {
+			names = append(names, c.City)
+		}
+		return names, err
+	})
+}
+
+func (s *apiSource) stations(ctx context.Context, city, state, country string) ([]string, error) {
+	return s.list(ctx, indexKey(country, state, city, ""), func() ([]string, error) {
+		stations, err := s.api.StationsContext(ctx, city, state, country)
+		var names []string
+		for _, st := range stations {
+			names = append(names, st.Station)
+		}
+		return names, err
+	})
+}
+
+// catalogSource list names from a catalog
+type catalogSource struct {
+	catalog *Catalog
+}
+
+func (s catalogSource) country(name string) *CatalogCountry {
+	for _, c := range s.catalog.Countries {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+func (s catalogSource) countries(ctx context.Context) ([]string, error) {
+	var names []string
+	for _, c := range s.catalog.Countries {
+		names = append(names, c.Name)
+	}
+
+	return names, nil
+}
+
+func (s catalogSource) states(ctx context.Context, country string) ([]string, error) {
+	var names []string
+	if c := s.country(country); c != nil {
+		for _, st := range c.States {
+			names = append(names, st.Name)
+		}
+	}
+
+	return names, nil
+}
+
+func (s catalogSource) state(name, country string) *CatalogState {
+	if c := s.country(country); c != nil {
+		for _, st := range c.States {
+			if st.Name == name {
+				return st
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s catalogSource) cities(ctx context.Context, state, country string) ([]string, error) {
+	var names []string
+	if st := s.state(state, country); st != nil {
+		for _, city := range st.Cities {
+			names = append(names, city.Name)
+		}
+	}
+
+	return names, nil
+}
+
+func (s catalogSource) stations(ctx context.Context, city, state, country string) ([]string, error) {
+	var names []string
+	if st := s.state(state, country); st != nil {
+		for _, c := range st.Cities {
+			if c.Name != city {
+				continue
+			}
+			for _, station := range c.Stations {
+				names = append(names, station.Name)
+			}
+		}
+	}
+
+	return names, nil
+}
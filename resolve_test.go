@@ -0,0 +1,209 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := map[string]string{
+		"São Paulo":                 "sao paulo",
+		"  sao-paulo ":              "sao paulo",
+		"Hồ Chí Minh":               "ho chi minh",
+		"Kraków":                    "krakow",
+		"Łódź":                      "lodz",
+		"Straße":                    "strasse",
+		"Los Angeles - N. Main St.": "los angeles n main st",
+		"Ciudad Juárez":             "ciudad juarez",
+		"Zürich":                    "zurich",
+		"Café":                     "cafe",
+	}
+
+	for name, want := range tests {
+		if got := NormalizeName(name); want != got {
+			t.Errorf("expected %q for %q , got %q", want, name, got)
+		}
+	}
+}
+
+func resolverCatalog() *Catalog {
+	return &Catalog{Countries: []*CatalogCountry{
+		{Name: "Brazil", States: []*CatalogState{{Name: "Sao Paulo", Cities: []*CatalogCity{{Name: "Sao Paulo"}, {Name: "Campinas"}}}}},
+		{Name: "France", States: []*CatalogState{{Name: "Ile-de-France", Cities: []*CatalogCity{{Name: "Paris 01"}, {Name: "Paris 02"}}}}},
+		{Name: "USA", States: []*CatalogState{
+			{Name: "California", Cities: []*CatalogCity{{Name: "Los Angeles"}, {Name: "San Francisco"}}},
+			{Name: "New York", Cities: []*CatalogCity{{Name: "New York City"}, {Name: "Buffalo"}}},
+		}},
+		{Name: "Vietnam", States: []*CatalogState{{Name: "Ho Chi Minh City", Cities: []*CatalogCity{{Name: "Ho Chi Minh City"}}}}},
+	}}
+}
+
+func TestResolveCity(t *testing.T) {
+	resolver := NewCatalogResolver(resolverCatalog())
+	resolver.AddAlias(CityAlias, "Big Apple", "New York City")
+
+	tests := []struct {
+		name                 string
+		city, state, country string
+		want                 CityRef
+		err                  error
+	}{
+		{name: "diacritics", city: "são paulo", state: "SAO PAULO", country: "brasil", want: CityRef{"Sao Paulo", "Sao Paulo", "Brazil"}},
+		{name: "missing word", city: "Ho Chi Minh", state: "ho chi minh", country: "Viet Nam", want: CityRef{"Ho Chi Minh City", "Ho Chi Minh City", "Vietnam"}},
+		{name: "country alias", city: "new york city", state: "new york", country: "United States", want: CityRef{"New York City", "New York", "USA"}},
+		{name: "city alias", city: "sf", state: "california", country: "usa", want: CityRef{"San Francisco", "California", "USA"}},
+		{name: "custom alias", city: "big apple", state: "new york", country: "usa", want: CityRef{"New York City", "New York", "USA"}},
+		{name: "typo", city: "Los Angelos", state: "Califronia", country: "USA", want: CityRef{"Los Angeles", "California", "USA"}},
+		{name: "without state", city: "buffalo", country: "USA", want: CityRef{"Buffalo", "New York", "USA"}},
+		{name: "unknown city", city: "Fresno", state: "California", country: "USA", err: ErrNoMatch},
+		{name: "unknown country", city: "Lyon", state: "Rhone", country: "Atlantis", err: ErrNoMatch},
+		{name: "ambiguous", city: "Paris", state: "Ile de France", country: "France", err: ErrAmbiguous},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := resolver.ResolveCity(context.Background(), test.city, test.state, test.country)
+
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v , got %v", test.err, err)
+			}
+			if test.want != got {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestResolveStateNoMatch(t *testing.T) {
+	resolver := NewCatalogResolver(resolverCatalog())
+
+	state, country, err := resolver.ResolveState(context.Background(), "Texas", "USA")
+	if !errors.Is(err, ErrNoMatch) {
+		t.Errorf("expected %v , got %v", ErrNoMatch, err)
+	}
+	if state != "" || country != "" {
+		t.Errorf("expected empty names on error , got %q %q", state, country)
+	}
+}
+
+func TestResolverRank(t *testing.T) {
+	resolver := NewCatalogResolver(&Catalog{})
+
+	matches := resolver.Rank(CityAlias, "new york", []string{"York", "New York City", "New York", "Newark"})
+	if len(matches) < 2 || matches[0].Name != "New York" || matches[1].Name != "New York City" {
+		t.Errorf("expected New York then New York City , got %v", matches)
+	}
+	if matches[0].Score != 1 {
+		t.Errorf("expected exact match to score 1 , got %v", matches[0].Score)
+	}
+}
+
+func TestResolverConcurrentAliases(t *testing.T) {
+	resolver := NewCatalogResolver(resolverCatalog())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resolver.AddAlias(CityAlias, fmt.Sprintf("alias %d", i), "Buffalo")
+			resolver.Rank(CityAlias, "alias", []string{"Buffalo"})
+		}(i)
+	}
+	wg.Wait()
+
+	if _, err := resolver.ResolveCity(context.Background(), "alias 3", "New York", "USA"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResolverAPIBlockingFetch(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		switch r.URL.Path {
+		case countriesEndpoint:
+			w.Write([]byte(`{"status":"success","data":[{"country":"USA"}]}`))
+		case statesEndpoint:
+			<-release
+			w.Write([]byte(`{"status":"success","data":[{"state":"California"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewResolver(New("API Key", WithBaseEndpoint(server.URL)))
+	if _, err := resolver.ResolveCountry(context.Background(), "usa"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := resolver.ResolveState(context.Background(), "CA", "US"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+
+	resolved := make(chan error)
+	go func() {
+		_, err := resolver.ResolveCountry(context.Background(), "United States")
+		resolved <- err
+	}()
+	select {
+	case err := <-resolved:
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("expected cached countries to resolve while states are fetched")
+	}
+
+	close(release)
+	wg.Wait()
+
+	if calls != 2 {
+		t.Errorf("expected states to be fetched once , got %d calls", calls)
+	}
+}
+
+func TestResolverAPI(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		switch r.URL.Path {
+		case countriesEndpoint:
+			w.Write([]byte(`{"status":"success","data":[{"country":"Brazil"},{"country":"USA"}]}`))
+		case statesEndpoint:
+			w.Write([]byte(`{"status":"success","data":[{"state":"Sao Paulo"},{"state":"Rio de Janeiro"}]}`))
+		case citiesEndpoint:
+			w.Write([]byte(`{"status":"success","data":[{"city":"Sao Paulo"},{"city":"Campinas"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewResolver(New("API Key", WithBaseEndpoint(server.URL)))
+
+	for i := 0; i < 2; i++ {
+		got, err := resolver.ResolveCity(context.Background(), "São Paulo", "são paulo", "brazil")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := (CityRef{"Sao Paulo", "Sao Paulo", "Brazil"}); want != got {
+			t.Errorf("expected %v , got %v", want, got)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("expected lists to be fetched once , got %d calls", calls)
+	}
+}
@@ -0,0 +1,205 @@
+package airvisual
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RetryClass is a set of error classes that should be retried
+type RetryClass int
+
+// Error classes that can be retried, combine them with bitwise OR
+const (
+	RetryNetwork     RetryClass = 1 << iota // connection errors and timeouts
+	RetryServerError                        // HTTP 5xx responses
+	RetryRateLimited                        // HTTP 429 and too_many_requests responses
+	RetryCallLimit                          // call_limit_reached responses
+	RetryInvalidJSON                        // responses that cannot be decoded
+)
+
+// RetryPolicy configure how failed requests are retried
+type RetryPolicy struct {
+	MaxAttempts       int           // total number of attempts, including the first one
+	BaseDelay         time.Duration // delay before the first retry, doubled on every following retry
+	MaxDelay          time.Duration // upper bound of the delay between attempts, 0 means unbounded
+	Jitter            float64       // fraction of the delay that is randomized, between 0 and 1
+	RetryOn           RetryClass    // error classes that should be retried
+	RespectRetryAfter bool          // wait for the Retry-After header duration when present, a longer one than MaxDelay ends retries
+}
+
+// DefaultRetryPolicy return a retry policy suitable for polling AirVisual's API
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       3,
+		BaseDelay:         500 * time.Millisecond,
+		MaxDelay:          30 * time.Second,
+		Jitter:            0.5,
+		RetryOn:           RetryNetwork | RetryServerError | RetryRateLimited,
+		RespectRetryAfter: true,
+	}
+}
+
+// WithRetry set retry policy on airvisual client
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = &policy
+	}
+}
+
+// RetryError is returned when every attempt of a request failed. Unwrap only return the last error, so errors.Is
+// and errors.As only see the last attempt, e.g. a 429 followed by a 5xx does not match ErrTooManyRequests,
+// range over Errors to check earlier attempts
+type RetryError struct {
+	Errors []error // error of every attempt, in order
+}
+
+func (e *RetryError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = "attempt " + strconv.Itoa(i+1) + ": " + err.Error()
+	}
+
+	return "all " + strconv.Itoa(len(e.Errors)) + " attempts failed: " + strings.Join(messages, "; ")
+}
+
+// Unwrap return the error of the last attempt
+func (e *RetryError) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+
+	return e.Errors[len(e.Errors)-1]
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+func (p *RetryPolicy) classify(err error) RetryClass {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return 0
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case errors.Is(apiErr, ErrTooManyRequests):
+			return RetryRateLimited
+		case errors.Is(apiErr, ErrCallLimitReached):
+			return RetryCallLimit
+		case apiErr.HTTPStatus >= http.StatusInternalServerError:
+			return RetryServerError
+		}
+		return 0
+	}
+
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return RetryNetwork
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return RetryInvalidJSON
+	}
+
+	return 0
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	class := p.classify(err)
+
+	return class != 0 && p.RetryOn&class != 0
+}
+
+func (p *RetryPolicy) delay(attempt int, err error) (time.Duration, bool) {
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(attempt-1)))
+	if p.MaxDelay > 0 && (delay > p.MaxDelay || delay < 0) {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		jitterMu.Lock()
+		r := jitterRand.Float64()
+		jitterMu.Unlock()
+
+		delay -= time.Duration(float64(delay) * math.Min(p.Jitter, 1) * r)
+	}
+
+	var apiErr *APIError
+	if p.RespectRetryAfter && errors.As(err, &apiErr) && apiErr.RetryAfter > delay {
+		if p.MaxDelay > 0 && apiErr.RetryAfter > p.MaxDelay {
+			return 0, false
+		}
+		delay = apiErr.RetryAfter
+	}
+
+	return delay, true
+}
+
+func (c *Client) fetchWithRetry(ctx context.Context, api string, v url.Values) ([]byte, error) {
+	policy := c.retry
+
+	var errs []error
+	for attempt := 1; ; attempt++ {
+		body, err := c.fetch(ctx, api, v)
+		if err == nil {
+			return body, nil
+		}
+		errs = append(errs, err)
+
+		if attempt >= policy.MaxAttempts || !policy.retryable(err) {
+			break
+		}
+
+		delay, ok := policy.delay(attempt, err)
+		if !ok {
+			break
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			errs = append(errs, ctx.Err())
+			return nil, &RetryError{Errors: errs}
+		case <-timer.C:
+		}
+	}
+
+	if len(errs) == 1 {
+		return nil, errs[0]
+	}
+
+	return nil, &RetryError{Errors: errs}
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
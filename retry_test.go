@@ -0,0 +1,193 @@
+package airvisual
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type mockResponse struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+func mockSequenceServer(responses []mockResponse, opts ...Option) (*Client, *httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&calls, 1)) - 1
+		if i >= len(responses) {
+			i = len(responses) - 1
+		}
+
+		if responses[i].retryAfter != "" {
+			w.Header().Set("Retry-After", responses[i].retryAfter)
+		}
+		w.WriteHeader(responses[i].status)
+		w.Write([]byte(responses[i].body))
+	}))
+
+	client := New("API Key", append([]Option{WithHTTPClient(server.Client())}, opts...)...)
+	client.baseEndpoint = server.URL
+
+	return client, server, &calls
+}
+
+func TestRetry(t *testing.T) {
+	success := mockResponse{status: http.StatusOK, body: `{"status": "success", "data": [{"country": "USA"}]}`}
+	unavailable := mockResponse{status: http.StatusServiceUnavailable}
+	notFound := mockResponse{status: http.StatusBadRequest, body: `{"status": "fail", "data": {"message": "city_not_found"}}`}
+	limited := mockResponse{status: http.StatusTooManyRequests, retryAfter: "1"}
+
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+		Jitter:      0.5,
+		RetryOn:     RetryNetwork | RetryServerError | RetryRateLimited,
+	}
+	respectful := policy
+	respectful.RespectRetryAfter = true
+
+	tests := []struct {
+		name      string
+		policy    RetryPolicy
+		responses []mockResponse
+		calls     int32
+		attempts  int
+		sentinel  error
+	}{
+		{
+			name:      "success after transient failures",
+			policy:    policy,
+			responses: []mockResponse{unavailable, unavailable, success},
+			calls:     3,
+		},
+		{
+			name:      "retries exhausted",
+			policy:    policy,
+			responses: []mockResponse{unavailable},
+			calls:     3,
+			attempts:  3,
+		},
+		{
+			name:      "non retryable error",
+			policy:    policy,
+			responses: []mockResponse{notFound},
+			calls:     1,
+			attempts:  1,
+			sentinel:  ErrCityNotFound,
+		},
+		{
+			name:      "rate limited retried",
+			policy:    policy,
+			responses: []mockResponse{limited, success},
+			calls:     2,
+		},
+		{
+			name:      "retry after beyond max delay",
+			policy:    respectful,
+			responses: []mockResponse{limited, success},
+			calls:     1,
+			attempts:  1,
+			sentinel:  ErrTooManyRequests,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, server, calls := mockSequenceServer(test.responses, WithRetry(test.policy))
+			defer server.Close()
+
+			_, err := client.Countries()
+
+			if test.calls != atomic.LoadInt32(calls) {
+				t.Errorf("expected %d calls , got %d", test.calls, atomic.LoadInt32(calls))
+			}
+			if test.attempts == 0 && err != nil {
+				t.Errorf("expected no error , got %v", err)
+			}
+			if test.attempts > 1 {
+				var retryErr *RetryError
+				if !errors.As(err, &retryErr) {
+					t.Fatalf("expected *RetryError , got %#v", err)
+				}
+				if test.attempts != len(retryErr.Errors) {
+					t.Errorf("expected %d attempt errors , got %d", test.attempts, len(retryErr.Errors))
+				}
+			}
+			if test.sentinel != nil && !errors.Is(err, test.sentinel) {
+				t.Errorf("expected error to match %v , got %v", test.sentinel, err)
+			}
+		})
+	}
+}
+
+func TestRetryErrorLastAttempt(t *testing.T) {
+	limited := mockResponse{status: http.StatusTooManyRequests}
+	unavailable := mockResponse{status: http.StatusServiceUnavailable}
+	policy := RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, RetryOn: RetryServerError | RetryRateLimited}
+
+	client, server, _ := mockSequenceServer([]mockResponse{limited, unavailable}, WithRetry(policy))
+	defer server.Close()
+
+	_, err := client.Countries()
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("expected *RetryError , got %#v", err)
+	}
+	if errors.Is(err, ErrTooManyRequests) {
+		t.Errorf("expected only the last attempt to be matched , got %v", err)
+	}
+	if !errors.Is(retryErr.Errors[0], ErrTooManyRequests) {
+		t.Errorf("expected first attempt to match %v , got %v", ErrTooManyRequests, retryErr.Errors[0])
+	}
+}
+
+func TestRetryContext(t *testing.T) {
+	client, server, calls := mockSequenceServer(
+		[]mockResponse{{status: http.StatusServiceUnavailable}},
+		WithRetry(RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, RetryOn: RetryServerError}),
+	)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.CountriesContext(ctx)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error to match %v , got %v", context.DeadlineExceeded, err)
+	}
+	if atomic.LoadInt32(calls) != 1 {
+		t.Errorf("expected 1 call , got %d", atomic.LoadInt32(calls))
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty", value: "", want: 0},
+		{name: "seconds", value: "120", want: 2 * time.Minute},
+		{name: "invalid", value: "soon", want: 0},
+		{name: "past date", value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseRetryAfter(test.value)
+
+			if test.want != got {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+		})
+	}
+}
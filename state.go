@@ -1,6 +1,7 @@
 package airvisual
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -12,22 +13,21 @@ type States struct {
 
 // States list supported states in the specified country
 func (c *Client) States(country string) ([]*States, error) {
+	return c.StatesContext(context.Background(), country)
+}
+
+// StatesContext is like States but takes a context to cancel the request
+func (c *Client) StatesContext(ctx context.Context, country string) ([]*States, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 	v.Add("country", country)
 
-	endpoint := c.endpoint(statesEndpoint, v)
-
 	payload := struct {
-		Status string    `json:"status"`
-		Data   []*States `json:"data"`
+		Data []*States `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, statesEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list states: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to list states: %v", payload.Status)
+		return nil, fmt.Errorf("unable to list states: %w", err)
 	}
 
 	return payload.Data, nil
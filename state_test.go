@@ -2,6 +2,7 @@ package airvisual
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -39,7 +40,11 @@ func TestStates(t *testing.T) {
   "data": []
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to list states: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to list states: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   statesEndpoint,
+			}),
 		},
 	}
 
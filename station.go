@@ -1,6 +1,7 @@
 package airvisual
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -14,24 +15,23 @@ type Stations struct {
 
 // Stations list supported active stations inside a specified city
 func (c *Client) Stations(city, state, country string) ([]*Stations, error) {
+	return c.StationsContext(context.Background(), city, state, country)
+}
+
+// StationsContext is like Stations but takes a context to cancel the request
+func (c *Client) StationsContext(ctx context.Context, city, state, country string) ([]*Stations, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 	v.Add("country", country)
 	v.Add("state", state)
 	v.Add("city", city)
 
-	endpoint := c.endpoint(stationsEndpoint, v)
-
 	payload := struct {
-		Status string      `json:"status"`
-		Data   []*Stations `json:"data"`
+		Data []*Stations `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, stationsEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list stations: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to list stations: %v", payload.Status)
+		return nil, fmt.Errorf("unable to list stations: %w", err)
 	}
 
 	return payload.Data, nil
@@ -51,6 +51,15 @@ type Station struct {
 
 // Station return specified station's data object
 func (c *Client) Station(station, city, state, country string) (*Station, error) {
+	return c.StationContext(context.Background(), station, city, state, country)
+}
+
+// StationContext is like Station but takes a context to cancel the request
+func (c *Client) StationContext(ctx context.Context, station, city, state, country string) (*Station, error) {
+	if err := c.checkStation(station, city, state, country); err != nil {
+		return nil, fmt.Errorf("unable to retrieve station data: %w", err)
+	}
+
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 	v.Add("country", country)
@@ -58,18 +67,12 @@ func (c *Client) Station(station, city, state, country string) (*Station, error)
 	v.Add("city", city)
 	v.Add("station", station)
 
-	endpoint := c.endpoint(stationEndpoint, v)
-
 	payload := struct {
-		Status string   `json:"status"`
-		Data   *Station `json:"data"`
+		Data *Station `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, stationEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve station data: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to retrieve station data: %v", payload.Status)
+		return nil, fmt.Errorf("unable to retrieve station data: %w", err)
 	}
 
 	return payload.Data, nil
@@ -77,47 +80,65 @@ func (c *Client) Station(station, city, state, country string) (*Station, error)
 
 // NearestStationIP return nearest station's data using IP address geolocation
 func (c *Client) NearestStationIP() (*Station, error) {
+	return c.NearestStationIPContext(context.Background())
+}
+
+// NearestStationIPContext is like NearestStationIP but takes a context to cancel the request
+func (c *Client) NearestStationIPContext(ctx context.Context) (*Station, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 
-	endpoint := c.endpoint(nearestStationEndpoint, v)
-
 	payload := struct {
-		Status string   `json:"status"`
-		Data   *Station `json:"data"`
+		Data *Station `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, nearestStationEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve nearest station by IP address geolocation: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to retrieve nearest station by IP address geolocation: %v", payload.Status)
+		return nil, fmt.Errorf("unable to retrieve nearest station by IP address geolocation: %w", err)
 	}
 
 	return payload.Data, nil
-
 }
 
 // NearestStationGPS return nearest station's data using specified GPS coordinates
 func (c *Client) NearestStationGPS(lat, lon float64) (*Station, error) {
+	return c.NearestStationGPSContext(context.Background(), lat, lon)
+}
+
+// NearestStationGPSContext is like NearestStationGPS but takes a context to cancel the request
+func (c *Client) NearestStationGPSContext(ctx context.Context, lat, lon float64) (*Station, error) {
 	v := url.Values{}
 	v.Add("key", c.APIKey)
 	v.Add("lat", strconv.FormatFloat(lat, 'f', -1, 64))
 	v.Add("lon", strconv.FormatFloat(lon, 'f', -1, 64))
 
-	endpoint := c.endpoint(nearestStationEndpoint, v)
-
 	payload := struct {
-		Status string   `json:"status"`
-		Data   *Station `json:"data"`
+		Data *Station `json:"data"`
 	}{}
-	err := c.request(endpoint, &payload)
+	err := c.request(ctx, nearestStationEndpoint, v, &payload)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve nearest station by GPS coordinates: %v", err)
-	}
-	if payload.Status != "success" {
-		return nil, fmt.Errorf("unable to retrieve nearest station by GPS coordinates: %v", payload.Status)
+		return nil, fmt.Errorf("unable to retrieve nearest station by GPS coordinates: %w", err)
 	}
 
 	return payload.Data, nil
 }
+
+// NearestStationGPSDistance is like NearestStationGPS but also return the distance in kilometers between the station
+// and the coordinates, e.g. to reject stations too far away to be representative
+func (c *Client) NearestStationGPSDistance(lat, lon float64) (*Station, float64, error) {
+	return c.NearestStationGPSDistanceContext(context.Background(), lat, lon)
+}
+
+// NearestStationGPSDistanceContext is like NearestStationGPSDistance but takes a context to cancel the request
+func (c *Client) NearestStationGPSDistanceContext(ctx context.Context, lat, lon float64) (*Station, float64, error) {
+	station, err := c.NearestStationGPSContext(ctx, lat, lon)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	distance, err := station.DistanceFrom(lat, lon)
+	if err != nil {
+		return station, 0, fmt.Errorf("unable to compute distance to nearest station: %w", err)
+	}
+
+	return station, distance, nil
+}
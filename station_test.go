@@ -2,6 +2,7 @@ package airvisual
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -65,7 +66,11 @@ func TestStations(t *testing.T) {
   "data": []
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to list stations: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to list stations: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   stationsEndpoint,
+			}),
 		},
 	}
 
@@ -283,7 +288,7 @@ func TestStation(t *testing.T) {
 				},
 				Forecasts: []*Forecast{
 					{
-						TS:    "2019-08-05T03:00:00.000Z",
+						TS:    mustTimestamp("2019-08-05T03:00:00.000Z"),
 						AQIUS: 41,
 						AQICN: 14,
 						TP:    25,
@@ -295,14 +300,14 @@ func TestStation(t *testing.T) {
 						IC:    "03n",
 					},
 					{
-						TS:    "2019-08-07T00:00:00.000Z",
+						TS:    mustTimestamp("2019-08-07T00:00:00.000Z"),
 						AQIUS: 68,
 						AQICN: 29,
 					},
 				},
 				Current: &Current{
 					Weather: &Weather{
-						TS: "2019-08-01T23:00:00.000Z",
+						TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 						TP: 37,
 						PR: 1007,
 						HU: 14,
@@ -311,7 +316,7 @@ func TestStation(t *testing.T) {
 						IC: "01d",
 					},
 					Pollution: &Pollution{
-						TS:     "2019-08-04T19:00:00.000Z",
+						TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 						AQIUS:  70,
 						MAINUS: "p2",
 						AQICN:  30,
@@ -351,7 +356,7 @@ func TestStation(t *testing.T) {
 				History: &History{
 					Weather: []*Weather{
 						{
-							TS: "2019-08-01T23:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 							TP: 37,
 							PR: 1007,
 							HU: 14,
@@ -360,7 +365,7 @@ func TestStation(t *testing.T) {
 							IC: "01d",
 						},
 						{
-							TS: "2019-08-01T04:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T04:00:00.000Z"),
 							TP: 31,
 							PR: 1005,
 							HU: 26,
@@ -371,7 +376,7 @@ func TestStation(t *testing.T) {
 					},
 					Pollution: []*Pollution{
 						{
-							TS:     "2019-08-04T19:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 							AQIUS:  70,
 							MAINUS: "p2",
 							AQICN:  30,
@@ -408,7 +413,7 @@ func TestStation(t *testing.T) {
 							},
 						},
 						{
-							TS:     "2019-08-04T18:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T18:00:00.000Z"),
 							AQIUS:  57,
 							MAINUS: "p2",
 							AQICN:  28,
@@ -451,7 +456,11 @@ func TestStation(t *testing.T) {
   "data": null
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to retrieve station data: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to retrieve station data: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   stationEndpoint,
+			}),
 		},
 	}
 
@@ -669,7 +678,7 @@ func TestNearestStationIP(t *testing.T) {
 				},
 				Forecasts: []*Forecast{
 					{
-						TS:    "2019-08-05T03:00:00.000Z",
+						TS:    mustTimestamp("2019-08-05T03:00:00.000Z"),
 						AQIUS: 41,
 						AQICN: 14,
 						TP:    25,
@@ -681,14 +690,14 @@ func TestNearestStationIP(t *testing.T) {
 						IC:    "03n",
 					},
 					{
-						TS:    "2019-08-07T00:00:00.000Z",
+						TS:    mustTimestamp("2019-08-07T00:00:00.000Z"),
 						AQIUS: 68,
 						AQICN: 29,
 					},
 				},
 				Current: &Current{
 					Weather: &Weather{
-						TS: "2019-08-01T23:00:00.000Z",
+						TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 						TP: 37,
 						PR: 1007,
 						HU: 14,
@@ -697,7 +706,7 @@ func TestNearestStationIP(t *testing.T) {
 						IC: "01d",
 					},
 					Pollution: &Pollution{
-						TS:     "2019-08-04T19:00:00.000Z",
+						TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 						AQIUS:  70,
 						MAINUS: "p2",
 						AQICN:  30,
@@ -737,7 +746,7 @@ func TestNearestStationIP(t *testing.T) {
 				History: &History{
 					Weather: []*Weather{
 						{
-							TS: "2019-08-01T23:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 							TP: 37,
 							PR: 1007,
 							HU: 14,
@@ -746,7 +755,7 @@ func TestNearestStationIP(t *testing.T) {
 							IC: "01d",
 						},
 						{
-							TS: "2019-08-01T04:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T04:00:00.000Z"),
 							TP: 31,
 							PR: 1005,
 							HU: 26,
@@ -757,7 +766,7 @@ func TestNearestStationIP(t *testing.T) {
 					},
 					Pollution: []*Pollution{
 						{
-							TS:     "2019-08-04T19:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 							AQIUS:  70,
 							MAINUS: "p2",
 							AQICN:  30,
@@ -794,7 +803,7 @@ func TestNearestStationIP(t *testing.T) {
 							},
 						},
 						{
-							TS:     "2019-08-04T18:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T18:00:00.000Z"),
 							AQIUS:  57,
 							MAINUS: "p2",
 							AQICN:  28,
@@ -837,7 +846,11 @@ func TestNearestStationIP(t *testing.T) {
   "data": null
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to retrieve nearest station by IP address geolocation: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to retrieve nearest station by IP address geolocation: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   nearestStationEndpoint,
+			}),
 		},
 	}
 
@@ -1055,7 +1068,7 @@ func TestNearestStationGPS(t *testing.T) {
 				},
 				Forecasts: []*Forecast{
 					{
-						TS:    "2019-08-05T03:00:00.000Z",
+						TS:    mustTimestamp("2019-08-05T03:00:00.000Z"),
 						AQIUS: 41,
 						AQICN: 14,
 						TP:    25,
@@ -1067,14 +1080,14 @@ func TestNearestStationGPS(t *testing.T) {
 						IC:    "03n",
 					},
 					{
-						TS:    "2019-08-07T00:00:00.000Z",
+						TS:    mustTimestamp("2019-08-07T00:00:00.000Z"),
 						AQIUS: 68,
 						AQICN: 29,
 					},
 				},
 				Current: &Current{
 					Weather: &Weather{
-						TS: "2019-08-01T23:00:00.000Z",
+						TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 						TP: 37,
 						PR: 1007,
 						HU: 14,
@@ -1083,7 +1096,7 @@ func TestNearestStationGPS(t *testing.T) {
 						IC: "01d",
 					},
 					Pollution: &Pollution{
-						TS:     "2019-08-04T19:00:00.000Z",
+						TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 						AQIUS:  70,
 						MAINUS: "p2",
 						AQICN:  30,
@@ -1123,7 +1136,7 @@ func TestNearestStationGPS(t *testing.T) {
 				History: &History{
 					Weather: []*Weather{
 						{
-							TS: "2019-08-01T23:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T23:00:00.000Z"),
 							TP: 37,
 							PR: 1007,
 							HU: 14,
@@ -1132,7 +1145,7 @@ func TestNearestStationGPS(t *testing.T) {
 							IC: "01d",
 						},
 						{
-							TS: "2019-08-01T04:00:00.000Z",
+							TS: mustTimestamp("2019-08-01T04:00:00.000Z"),
 							TP: 31,
 							PR: 1005,
 							HU: 26,
@@ -1143,7 +1156,7 @@ func TestNearestStationGPS(t *testing.T) {
 					},
 					Pollution: []*Pollution{
 						{
-							TS:     "2019-08-04T19:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T19:00:00.000Z"),
 							AQIUS:  70,
 							MAINUS: "p2",
 							AQICN:  30,
@@ -1180,7 +1193,7 @@ func TestNearestStationGPS(t *testing.T) {
 							},
 						},
 						{
-							TS:     "2019-08-04T18:00:00.000Z",
+							TS:     mustTimestamp("2019-08-04T18:00:00.000Z"),
 							AQIUS:  57,
 							MAINUS: "p2",
 							AQICN:  28,
@@ -1223,7 +1236,11 @@ func TestNearestStationGPS(t *testing.T) {
   "data": null
 }`,
 			want: nil,
-			err:  fmt.Errorf("unable to retrieve nearest station by GPS coordinates: %v", "call_limit_reached"),
+			err: fmt.Errorf("unable to retrieve nearest station by GPS coordinates: %w", &APIError{
+				HTTPStatus: http.StatusOK,
+				Status:     "call_limit_reached",
+				Endpoint:   nearestStationEndpoint,
+			}),
 		},
 	}
 
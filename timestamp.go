@@ -0,0 +1,185 @@
+package airvisual
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TimestampLayout is the layout of timestamps returned by AirVisual's API
+const TimestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// Timestamp is a time decoded from AirVisual's timestamp format, e.g. "2019-08-12T05:00:00.000Z"
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp return a Timestamp of the given time in UTC
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{t.UTC()}
+}
+
+// ParseTimestamp parse a timestamp in AirVisual's format, an empty string is the zero Timestamp
+func ParseTimestamp(value string) (Timestamp, error) {
+	if value == "" {
+		return Timestamp{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("invalid timestamp %q: %w", value, err)
+	}
+
+	return NewTimestamp(t), nil
+}
+
+// String return the timestamp in AirVisual's format, or an empty string for the zero Timestamp
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(TimestampLayout)
+}
+
+// MarshalJSON encode the timestamp in AirVisual's format
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decode a timestamp in AirVisual's format
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	var value string
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	*t, err = ParseTimestamp(value)
+
+	return err
+}
+
+// SortForecasts sort forecasts from the oldest to the newest
+func SortForecasts(forecasts []*Forecast) {
+	sort.SliceStable(forecasts, func(i, j int) bool {
+		return forecasts[i].TS.Before(forecasts[j].TS.Time)
+	})
+}
+
+// FilterForecasts return forecasts with a timestamp in [from, to), a zero bound is open
+func FilterForecasts(forecasts []*Forecast, from, to time.Time) []*Forecast {
+	var filtered []*Forecast
+	for _, f := range forecasts {
+		if inRange(f.TS, from, to) {
+			filtered = append(filtered, f)
+		}
+	}
+
+	return filtered
+}
+
+// WindowForecasts sort forecasts and group them into consecutive windows of the given size,
+// windows are aligned to multiples of size since the zero time and empty windows are skipped
+func WindowForecasts(forecasts []*Forecast, size time.Duration) [][]*Forecast {
+	sorted := append([]*Forecast{}, forecasts...)
+	SortForecasts(sorted)
+
+	var windows [][]*Forecast
+	var current time.Time
+	for _, f := range sorted {
+		start := f.TS.Truncate(size)
+		if len(windows) == 0 || !start.Equal(current) {
+			windows = append(windows, nil)
+			current = start
+		}
+		windows[len(windows)-1] = append(windows[len(windows)-1], f)
+	}
+
+	return windows
+}
+
+// Sort sort weather and pollution history from the oldest to the newest
+func (h *History) Sort() {
+	sort.SliceStable(h.Weather, func(i, j int) bool {
+		return h.Weather[i].TS.Before(h.Weather[j].TS.Time)
+	})
+	sort.SliceStable(h.Pollution, func(i, j int) bool {
+		return h.Pollution[i].TS.Before(h.Pollution[j].TS.Time)
+	})
+}
+
+// Filter return history with a timestamp in [from, to), a zero bound is open
+func (h *History) Filter(from, to time.Time) *History {
+	filtered := &History{}
+	for _, w := range h.Weather {
+		if inRange(w.TS, from, to) {
+			filtered.Weather = append(filtered.Weather, w)
+		}
+	}
+	for _, p := range h.Pollution {
+		if inRange(p.TS, from, to) {
+			filtered.Pollution = append(filtered.Pollution, p)
+		}
+	}
+
+	return filtered
+}
+
+// Window sort history and group it into consecutive windows of the given size,
+// windows are aligned to multiples of size since the zero time and empty windows are skipped
+func (h *History) Window(size time.Duration) []*History {
+	sorted := &History{
+		Weather:   append([]*Weather{}, h.Weather...),
+		Pollution: append([]*Pollution{}, h.Pollution...),
+	}
+	sorted.Sort()
+
+	index := map[time.Time]*History{}
+	var starts []time.Time
+	window := func(ts Timestamp) *History {
+		start := ts.Truncate(size)
+		w, ok := index[start]
+		if !ok {
+			w = &History{}
+			index[start] = w
+			starts = append(starts, start)
+		}
+		return w
+	}
+
+	for _, w := range sorted.Weather {
+		window(w.TS).Weather = append(window(w.TS).Weather, w)
+	}
+	for _, p := range sorted.Pollution {
+		window(p.TS).Pollution = append(window(p.TS).Pollution, p)
+	}
+
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+
+	windows := make([]*History, len(starts))
+	for i, start := range starts {
+		windows[i] = index[start]
+	}
+
+	return windows
+}
+
+func inRange(ts Timestamp, from, to time.Time) bool {
+	if !from.IsZero() && ts.Before(from) {
+		return false
+	}
+	if !to.IsZero() && !ts.Before(to) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,134 @@
+package airvisual
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func mustTimestamp(value string) Timestamp {
+	ts, err := ParseTimestamp(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return ts
+}
+
+func TestTimestampJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want Timestamp
+	}{
+		{
+			name: "airvisual format",
+			json: `"2019-08-12T05:00:00.000Z"`,
+			want: NewTimestamp(time.Date(2019, 8, 12, 5, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "milliseconds",
+			json: `"2019-08-12T05:00:00.123Z"`,
+			want: NewTimestamp(time.Date(2019, 8, 12, 5, 0, 0, 123000000, time.UTC)),
+		},
+		{
+			name: "empty",
+			json: `""`,
+			want: Timestamp{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Timestamp{}
+			err := json.Unmarshal([]byte(test.json), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("expected %v , got %v", test.want, got)
+			}
+
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.json != string(data) {
+				t.Errorf("expected %s , got %s", test.json, data)
+			}
+		})
+	}
+
+	err := json.Unmarshal([]byte(`"yesterday"`), &Timestamp{})
+	if err == nil {
+		t.Errorf("expected invalid timestamp error")
+	}
+}
+
+func TestForecastsHelpers(t *testing.T) {
+	forecasts := []*Forecast{
+		{TS: mustTimestamp("2019-08-05T06:00:00.000Z"), AQIUS: 3},
+		{TS: mustTimestamp("2019-08-05T03:00:00.000Z"), AQIUS: 2},
+		{TS: mustTimestamp("2019-08-06T00:00:00.000Z"), AQIUS: 4},
+		{TS: mustTimestamp("2019-08-04T21:00:00.000Z"), AQIUS: 1},
+	}
+
+	aqi := func(forecasts []*Forecast) []int {
+		values := []int{}
+		for _, f := range forecasts {
+			values = append(values, f.AQIUS)
+		}
+		return values
+	}
+
+	filtered := FilterForecasts(forecasts, time.Date(2019, 8, 5, 0, 0, 0, 0, time.UTC), time.Date(2019, 8, 6, 0, 0, 0, 0, time.UTC))
+	if want, got := []int{3, 2}, aqi(filtered); !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %v , got %v", want, got)
+	}
+
+	windows := WindowForecasts(forecasts, 24*time.Hour)
+	got := [][]int{}
+	for _, w := range windows {
+		got = append(got, aqi(w))
+	}
+	if want := [][]int{{1}, {2, 3}, {4}}; !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %v , got %v", want, got)
+	}
+
+	SortForecasts(forecasts)
+	if want, got := []int{1, 2, 3, 4}, aqi(forecasts); !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %v , got %v", want, got)
+	}
+}
+
+func TestHistoryHelpers(t *testing.T) {
+	history := &History{
+		Weather: []*Weather{
+			{TS: mustTimestamp("2019-08-01T23:00:00.000Z"), TP: 37},
+			{TS: mustTimestamp("2019-08-01T04:00:00.000Z"), TP: 31},
+		},
+		Pollution: []*Pollution{
+			{TS: mustTimestamp("2019-08-04T19:00:00.000Z"), AQIUS: 70},
+			{TS: mustTimestamp("2019-08-04T18:00:00.000Z"), AQIUS: 57},
+		},
+	}
+
+	filtered := history.Filter(time.Date(2019, 8, 1, 12, 0, 0, 0, time.UTC), time.Time{})
+	if len(filtered.Weather) != 1 || filtered.Weather[0].TP != 37 || len(filtered.Pollution) != 2 {
+		t.Errorf("unexpected filtered history %#v", filtered)
+	}
+
+	windows := history.Window(24 * time.Hour)
+	if len(windows) != 2 || len(windows[0].Weather) != 2 || len(windows[1].Pollution) != 2 {
+		t.Fatalf("unexpected history windows %#v", windows)
+	}
+	if windows[1].Pollution[0].AQIUS != 57 {
+		t.Errorf("expected windows to be sorted , got %#v", windows[1].Pollution)
+	}
+
+	history.Sort()
+	if history.Weather[0].TP != 31 || history.Pollution[0].AQIUS != 57 {
+		t.Errorf("expected history to be sorted , got %#v", history)
+	}
+}